@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
 )
 
 type createAccountRequest struct {
@@ -21,13 +22,15 @@ func (server *Server) createAccount(ctx *gin.Context) {
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	arg := db.CreateAccountParams{
-		Owner:    authPayload.Username,
-		Currency: req.Currency,
-		Balance:  0,
+	arg := db.CreateAccountTxParams{
+		CreateAccountParams: db.CreateAccountParams{
+			Owner:    authPayload.Username,
+			Currency: req.Currency,
+			Balance:  0,
+		},
 	}
 
-	account, err := server.store.CreateAccount(ctx, arg)
+	txResult, err := server.store.CreateAccountTx(ctx, arg)
 	if err != nil {
 		errCode := db.ErrorCode(err)
 		if errCode == db.ForeignKeyViolation || errCode == db.UniqueViolation {
@@ -38,7 +41,7 @@ func (server *Server) createAccount(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	ctx.JSON(http.StatusOK, txResult.Account)
 }
 
 type getAccountRequest struct {
@@ -74,8 +77,13 @@ func (server *Server) getAccount(ctx *gin.Context) {
 }
 
 type listAccountRequest struct {
-	PageID   int32 `form:"page_id" binding:"required,min=1"`
-	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+	PageSize  int32  `form:"page_size" binding:"required,min=1,max=50"`
+	PageToken string `form:"page_token"`
+}
+
+type listAccountResponse struct {
+	Accounts      []db.Account `json:"accounts"`
+	NextPageToken string       `json:"next_page_token"`
 }
 
 func (server *Server) listAccounts(ctx *gin.Context) {
@@ -85,11 +93,18 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 		return
 	}
 
+	pageToken, err := util.DecodePageToken(req.PageToken)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	arg := db.ListAccountsParams{
-		Owner:  authPayload.Username,
-		Limit:  req.PageSize,
-		Offset: (req.PageID - 1) * req.PageSize,
+		Owner:          authPayload.Username,
+		AfterCreatedAt: pageToken.CreatedAt,
+		AfterID:        pageToken.ID,
+		Limit:          req.PageSize + 1,
 	}
 
 	accounts, err := server.store.ListAccounts(ctx, arg)
@@ -98,5 +113,14 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, accounts)
+	rsp := listAccountResponse{
+		Accounts: accounts,
+	}
+	if len(accounts) > int(req.PageSize) {
+		rsp.Accounts = accounts[:req.PageSize]
+		last := rsp.Accounts[len(rsp.Accounts)-1]
+		rsp.NextPageToken = util.EncodePageToken(last.CreatedAt, last.ID)
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
 }
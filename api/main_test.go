@@ -1,12 +1,15 @@
 package api
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/token"
 	"github.com/spaghetti-lover/simplebank/util"
 	"github.com/stretchr/testify/require"
 )
@@ -20,9 +23,20 @@ func newTestServer(t *testing.T, store db.Store) *Server {
 	server, err := NewServer(config, store)
 	require.NoError(t, err)
 
+	// the session check is covered by TestAuthMiddlewareRevokedSession
+	server.sessionChecker = activeSessions{}
+	server.setupRouter()
+
 	return server
 }
 
+// activeSessions is a SessionChecker that honours every token
+type activeSessions struct{}
+
+func (activeSessions) CheckSession(ctx context.Context, payload *token.Payload) error { return nil }
+func (activeSessions) ForgetSession(sessionID uuid.UUID)                              {}
+func (activeSessions) ForgetUser(username string)                                     {}
+
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
 
@@ -8,6 +8,11 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/auth"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"github.com/spaghetti-lover/simplebank/token"
 	"github.com/spaghetti-lover/simplebank/util"
 	"github.com/stretchr/testify/require"
@@ -22,7 +27,7 @@ func addAuthorization(
 	role string,
 	duration time.Duration,
 ) {
-	token, payload, err := tokenMaker.CreateToken(username, role, duration, token.TokenTypeAccessToken)
+	token, payload, err := tokenMaker.CreateToken(username, role, uuid.Nil, duration, token.TokenTypeAccessToken)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
@@ -93,7 +98,7 @@ func TestAuthMiddleware(t *testing.T) {
 			authPath := "/auth"
 			server.router.GET(
 				authPath,
-				authMiddleware(server.tokenMaker),
+				authMiddleware(server.tokenMaker, server.sessionChecker),
 				func(ctx *gin.Context) {
 					ctx.JSON(http.StatusOK, gin.H{})
 				},
@@ -109,3 +114,35 @@ func TestAuthMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthMiddlewareRevokedSession(t *testing.T) {
+	username := util.RandomOwner()
+	role := util.DepositorRole
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetSessionAuthState(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.GetSessionAuthStateRow{Username: username, IsBlocked: true}, nil)
+
+	server := newTestServer(t, store)
+	authPath := "/auth"
+	server.router.GET(
+		authPath,
+		authMiddleware(server.tokenMaker, auth.NewCachedSessionChecker(store, time.Minute)),
+		func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, gin.H{})
+		},
+	)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, authPath, nil)
+	require.NoError(t, err)
+
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, username, role, time.Minute)
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
+	"github.com/spaghetti-lover/simplebank/auth"
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"github.com/spaghetti-lover/simplebank/token"
 	"github.com/spaghetti-lover/simplebank/util"
@@ -13,23 +14,32 @@ import (
 
 // Server serves HTTP requests for our banking service.
 type Server struct {
-	config     util.Config
-	store      db.Store
-	tokenMaker token.Maker
-	router     *gin.Engine
+	config         util.Config
+	store          db.Store
+	tokenMaker     token.Maker
+	passwordHasher util.PasswordHasher
+	sessionChecker auth.SessionChecker
+	router         *gin.Engine
 }
 
 // NewServer creates a new HTTP server and set up routing.
 func NewServer(config util.Config, store db.Store) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+	tokenMaker, err := token.NewMakerFromConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	passwordHasher, err := util.NewPasswordHasherFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create password hasher: %w", err)
+	}
+
 	server := &Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:         config,
+		store:          store,
+		tokenMaker:     tokenMaker,
+		passwordHasher: passwordHasher,
+		sessionChecker: auth.NewCachedSessionChecker(store, config.SessionCacheTTL),
 	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
@@ -47,7 +57,7 @@ func (server *Server) setupRouter() {
 	router.POST("/users/login", server.loginUser)
 	router.POST("/tokens/renew_access", server.renewAccessToken)
 
-	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.sessionChecker))
 	authRoutes.POST("/accounts", server.createAccount)
 	authRoutes.GET("/accounts/:id", server.getAccount)
 	authRoutes.GET("/accounts", server.listAccounts)
@@ -65,3 +75,12 @@ func (server *Server) Start(address string) error {
 func errorResponse(err error) gin.H {
 	return gin.H{"error": err.Error()}
 }
+
+// auditMetadata describes the request for the audit log, with actor as the user behind it
+func auditMetadata(ctx *gin.Context, actor string) db.AuditMetadata {
+	return db.AuditMetadata{
+		Actor:     actor,
+		ClientIP:  ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	}
+}
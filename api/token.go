@@ -49,6 +49,12 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	if session.IsRotated {
+		err := fmt.Errorf("rotated session")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
 	if session.Username != refreshPayload.Username {
 		err := fmt.Errorf("incorrect session user")
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
@@ -67,9 +73,21 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	user, err := server.store.GetUser(ctx, session.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// the access token carries the current role of the user rather than the one at login
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
-		refreshPayload.Username,
-		refreshPayload.Role,
+		user.Username,
+		user.Role,
+		session.ID,
 		server.config.AccessTokenDuration,
 		token.TokenTypeAccessToken,
 	)
@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+// Different types of login attempt store
+const (
+	LoginAttemptStoreMemory = "memory"
+	LoginAttemptStoreRedis  = "redis"
+)
+
+// maxTrackedLoginKeys bounds the memory used by MemoryLoginAttemptCounter
+const maxTrackedLoginKeys = 100000
+
+// LoginAttemptCounter counts login attempts per key within a fixed window
+type LoginAttemptCounter interface {
+	// Increment records an attempt for the key unless it already has limit of them,
+	// and returns the count with whether the attempt was recorded.
+	// The check and the increment are atomic, so concurrent attempts cannot go past the limit.
+	// The window of a key starts with its first attempt.
+	Increment(ctx context.Context, key string, limit int64, window time.Duration) (int64, bool, error)
+
+	// Decrement takes back an attempt recorded for the key
+	Decrement(ctx context.Context, key string) error
+
+	// Reset forgets the attempts recorded for the key
+	Reset(ctx context.Context, key string) error
+}
+
+// NewLoginAttemptCounterFromConfig creates the LoginAttemptCounter selected by LOGIN_ATTEMPT_STORE
+func NewLoginAttemptCounterFromConfig(config util.Config) (LoginAttemptCounter, error) {
+	switch config.LoginAttemptStore {
+	case "", LoginAttemptStoreMemory:
+		return NewMemoryLoginAttemptCounter(), nil
+	case LoginAttemptStoreRedis:
+		client := redis.NewClient(&redis.Options{
+			Addr: config.RedisAddress,
+		})
+		return NewRedisLoginAttemptCounter(client), nil
+	default:
+		return nil, fmt.Errorf("unsupported login attempt store: %s", config.LoginAttemptStore)
+	}
+}
+
+type memoryAttempts struct {
+	key       string
+	count     int64
+	expiresAt time.Time
+}
+
+// MemoryLoginAttemptCounter keeps the counters in the memory of a single server.
+// Once maxKeys are tracked, the key whose window started first is evicted.
+type MemoryLoginAttemptCounter struct {
+	mu       sync.Mutex
+	maxKeys  int
+	attempts map[string]*list.Element
+	// order holds the *memoryAttempts by the start of their window, oldest first
+	order *list.List
+}
+
+// NewMemoryLoginAttemptCounter creates a new in-memory LoginAttemptCounter
+func NewMemoryLoginAttemptCounter() LoginAttemptCounter {
+	return newMemoryLoginAttemptCounter(maxTrackedLoginKeys)
+}
+
+func newMemoryLoginAttemptCounter(maxKeys int) *MemoryLoginAttemptCounter {
+	return &MemoryLoginAttemptCounter{
+		maxKeys:  maxKeys,
+		attempts: make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (counter *MemoryLoginAttemptCounter) Increment(ctx context.Context, key string, limit int64, window time.Duration) (int64, bool, error) {
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+
+	now := time.Now()
+	element, ok := counter.attempts[key]
+	if ok && now.Before(element.Value.(*memoryAttempts).expiresAt) {
+		attempts := element.Value.(*memoryAttempts)
+		if attempts.count >= limit {
+			return attempts.count, false, nil
+		}
+		attempts.count++
+		return attempts.count, true, nil
+	}
+
+	// a new window starts
+	if limit <= 0 {
+		return 0, false, nil
+	}
+	if ok {
+		counter.remove(element)
+	}
+	if len(counter.attempts) >= counter.maxKeys {
+		counter.removeExpired(now)
+	}
+	for len(counter.attempts) >= counter.maxKeys {
+		counter.remove(counter.order.Front())
+	}
+
+	attempts := &memoryAttempts{key: key, count: 1, expiresAt: now.Add(window)}
+	counter.attempts[key] = counter.order.PushBack(attempts)
+	return attempts.count, true, nil
+}
+
+func (counter *MemoryLoginAttemptCounter) Decrement(ctx context.Context, key string) error {
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+
+	element, ok := counter.attempts[key]
+	if ok {
+		attempts := element.Value.(*memoryAttempts)
+		if attempts.count > 0 {
+			attempts.count--
+		}
+	}
+	return nil
+}
+
+func (counter *MemoryLoginAttemptCounter) Reset(ctx context.Context, key string) error {
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+
+	element, ok := counter.attempts[key]
+	if ok {
+		counter.remove(element)
+	}
+	return nil
+}
+
+func (counter *MemoryLoginAttemptCounter) remove(element *list.Element) {
+	counter.order.Remove(element)
+	delete(counter.attempts, element.Value.(*memoryAttempts).key)
+}
+
+func (counter *MemoryLoginAttemptCounter) removeExpired(now time.Time) {
+	for element := counter.order.Front(); element != nil; {
+		next := element.Next()
+		if !now.Before(element.Value.(*memoryAttempts).expiresAt) {
+			counter.remove(element)
+		}
+		element = next
+	}
+}
+
+// incrementScript checks the limit and increments in one step,
+// and sets the expiry together with the first increment so that a key can never be left without one
+var incrementScript = redis.NewScript(`
+local count = tonumber(redis.call("GET", KEYS[1]) or "0")
+if count >= tonumber(ARGV[1]) then
+  return {count, 0}
+end
+count = redis.call("INCR", KEYS[1])
+if count == 1 then
+  redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return {count, 1}
+`)
+
+// decrementScript never creates a key, which would have no expiry
+var decrementScript = redis.NewScript(`
+local count = tonumber(redis.call("GET", KEYS[1]) or "0")
+if count > 0 then
+  redis.call("DECR", KEYS[1])
+end
+return 0
+`)
+
+// RedisLoginAttemptCounter shares the counters between all servers through Redis
+type RedisLoginAttemptCounter struct {
+	client redis.UniversalClient
+}
+
+// NewRedisLoginAttemptCounter creates a new LoginAttemptCounter backed by Redis
+func NewRedisLoginAttemptCounter(client redis.UniversalClient) LoginAttemptCounter {
+	return &RedisLoginAttemptCounter{
+		client: client,
+	}
+}
+
+func redisLoginAttemptKey(key string) string {
+	return "login_attempts:" + key
+}
+
+func (counter *RedisLoginAttemptCounter) Increment(ctx context.Context, key string, limit int64, window time.Duration) (int64, bool, error) {
+	result, err := incrementScript.Run(ctx, counter.client, []string{redisLoginAttemptKey(key)}, limit, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to increment login attempts: %w", err)
+	}
+	return result[0], result[1] == 1, nil
+}
+
+func (counter *RedisLoginAttemptCounter) Decrement(ctx context.Context, key string) error {
+	err := decrementScript.Run(ctx, counter.client, []string{redisLoginAttemptKey(key)}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to decrement login attempts: %w", err)
+	}
+	return nil
+}
+
+func (counter *RedisLoginAttemptCounter) Reset(ctx context.Context, key string) error {
+	err := counter.client.Del(ctx, redisLoginAttemptKey(key)).Err()
+	if err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrLoginLocked is returned when a username or client IP has too many recent failed logins
+var ErrLoginLocked = errors.New("too many failed login attempts")
+
+// ErrMFAAttemptsExhausted is returned when a MFA token has been tried too many times
+var ErrMFAAttemptsExhausted = errors.New("too many attempts with this mfa token")
+
+// LoginGuardConfig tunes the brute-force protection of the login
+type LoginGuardConfig struct {
+	// MaxFailures locks a username after that many failures, zero disables the limit
+	MaxFailures int64
+	// MaxIPFailures locks a client IP after that many failures, zero disables the limit
+	MaxIPFailures int64
+	// LockoutDuration is the window in which failures are counted, and so how long a lockout lasts
+	LockoutDuration time.Duration
+	// FailureDelay is how long a failed attempt waits, doubled on every following failure
+	FailureDelay time.Duration
+	// MaxFailureDelay caps the wait of a failed attempt
+	MaxFailureDelay time.Duration
+	// MaxMFAAttempts is how many codes can be tried with one MFA token, zero disables the limit
+	MaxMFAAttempts int64
+}
+
+// LoginGuard slows down and then locks out repeated failed logins,
+// both per username and per client IP
+type LoginGuard struct {
+	counter LoginAttemptCounter
+	config  LoginGuardConfig
+}
+
+// NewLoginGuard creates a new LoginGuard that keeps its counters in counter
+func NewLoginGuard(counter LoginAttemptCounter, config LoginGuardConfig) *LoginGuard {
+	return &LoginGuard{
+		counter: counter,
+		config:  config,
+	}
+}
+
+func usernameKey(username string) string {
+	return "username:" + username
+}
+
+func mfaKey(tokenID uuid.UUID) string {
+	return "mfa:" + tokenID.String()
+}
+
+// ipKey drops the port that comes with the peer address
+func ipKey(clientIP string) string {
+	if host, _, err := net.SplitHostPort(clientIP); err == nil {
+		clientIP = host
+	}
+	return "ip:" + clientIP
+}
+
+// LoginAttempt is a login in progress.
+// It is counted as a failure from the start, until it is known to be something else.
+type LoginAttempt struct {
+	guard    *LoginGuard
+	username string
+	ipKey    string
+	failures int64
+	done     bool
+}
+
+// Begin records a login attempt of the username from the client IP, before the credentials are checked,
+// and returns ErrLoginLocked if either of them already has too many failures.
+// Counting first means that concurrent guesses cannot get past the limit.
+func (guard *LoginGuard) Begin(ctx context.Context, username string, clientIP string) (*LoginAttempt, error) {
+	attempt := &LoginAttempt{
+		guard:    guard,
+		username: username,
+	}
+
+	failures, ok, err := guard.counter.Increment(ctx, usernameKey(username), limit(guard.config.MaxFailures), guard.config.LockoutDuration)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrLoginLocked
+	}
+	attempt.failures = failures
+
+	if clientIP != "" {
+		_, ok, err = guard.counter.Increment(ctx, ipKey(clientIP), limit(guard.config.MaxIPFailures), guard.config.LockoutDuration)
+		if err == nil && !ok {
+			err = ErrLoginLocked
+		}
+		if err != nil {
+			// the username must not pay for an attempt that never happened
+			if decrementErr := guard.counter.Decrement(ctx, usernameKey(username)); decrementErr != nil {
+				return nil, decrementErr
+			}
+			return nil, err
+		}
+		attempt.ipKey = ipKey(clientIP)
+	}
+
+	return attempt, nil
+}
+
+// Fail keeps the attempt as a failure and waits for the progressive delay.
+// It returns true for the failure that locks the username out, so the owner is told only once.
+func (attempt *LoginAttempt) Fail(ctx context.Context) (bool, error) {
+	attempt.done = true
+
+	timer := time.NewTimer(attempt.guard.delay(attempt.failures))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-timer.C:
+	}
+
+	maxFailures := attempt.guard.config.MaxFailures
+	return maxFailures > 0 && attempt.failures == maxFailures, nil
+}
+
+// Succeed forgets the failures of the username and takes back the attempt of the client IP.
+// Earlier failures of the client IP are kept, as it may be trying many usernames.
+func (attempt *LoginAttempt) Succeed(ctx context.Context) error {
+	err := attempt.Release(ctx)
+	if err != nil {
+		return err
+	}
+	return attempt.guard.RecordSuccess(ctx, attempt.username)
+}
+
+// Release takes back an attempt that neither failed nor succeeded,
+// such as a right password that still waits for the second factor.
+// It does nothing once the attempt has failed or been released.
+func (attempt *LoginAttempt) Release(ctx context.Context) error {
+	if attempt.done {
+		return nil
+	}
+	attempt.done = true
+
+	err := attempt.guard.counter.Decrement(ctx, usernameKey(attempt.username))
+	if err != nil {
+		return err
+	}
+
+	if attempt.ipKey != "" {
+		return attempt.guard.counter.Decrement(ctx, attempt.ipKey)
+	}
+	return nil
+}
+
+// RecordSuccess forgets the failures of the username.
+// Failures of the client IP are kept, as it may be trying many usernames.
+func (guard *LoginGuard) RecordSuccess(ctx context.Context, username string) error {
+	return guard.counter.Reset(ctx, usernameKey(username))
+}
+
+// RecordMFAAttempt counts a code tried with the MFA token of the given ID,
+// and returns ErrMFAAttemptsExhausted once the token has been used up.
+// The count is kept until the token expires, so the token cannot be tried again.
+func (guard *LoginGuard) RecordMFAAttempt(ctx context.Context, tokenID uuid.UUID, expiredAt time.Time) error {
+	if guard.config.MaxMFAAttempts <= 0 {
+		return nil
+	}
+
+	_, ok, err := guard.counter.Increment(ctx, mfaKey(tokenID), guard.config.MaxMFAAttempts, time.Until(expiredAt))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrMFAAttemptsExhausted
+	}
+
+	return nil
+}
+
+// limit turns a disabled limit into one that is never reached
+func limit(max int64) int64 {
+	if max <= 0 {
+		return math.MaxInt64
+	}
+	return max
+}
+
+// delay returns how long the n-th consecutive failure waits
+func (guard *LoginGuard) delay(failures int64) time.Duration {
+	delay := guard.config.FailureDelay
+	for i := int64(1); i < failures && delay < guard.config.MaxFailureDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > guard.config.MaxFailureDelay {
+		delay = guard.config.MaxFailureDelay
+	}
+	return delay
+}
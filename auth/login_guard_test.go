@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLoginAttemptCounter(t *testing.T) {
+	counter := NewMemoryLoginAttemptCounter()
+	key := util.RandomString(10)
+
+	for i := int64(1); i <= 3; i++ {
+		count, ok, err := counter.Increment(context.Background(), key, 3, 100*time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, i, count)
+	}
+
+	// the limit is reached, nothing more is recorded
+	count, ok, err := counter.Increment(context.Background(), key, 3, 100*time.Millisecond)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, int64(3), count)
+
+	require.NoError(t, counter.Decrement(context.Background(), key))
+	count, ok, err = counter.Increment(context.Background(), key, 3, 100*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(3), count)
+
+	// the window started with the first attempt
+	time.Sleep(100 * time.Millisecond)
+	count, ok, err = counter.Increment(context.Background(), key, 3, time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(1), count)
+
+	require.NoError(t, counter.Reset(context.Background(), key))
+	count, _, err = counter.Increment(context.Background(), key, 3, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestMemoryLoginAttemptCounterMaxKeys(t *testing.T) {
+	counter := newMemoryLoginAttemptCounter(3)
+
+	keys := []string{"a", "b", "c", "d", "e"}
+	for _, key := range keys {
+		_, ok, err := counter.Increment(context.Background(), key, 5, time.Minute)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.LessOrEqual(t, len(counter.attempts), 3)
+		require.Equal(t, len(counter.attempts), counter.order.Len())
+	}
+
+	// the keys whose window started first were evicted
+	require.NotContains(t, counter.attempts, "a")
+	require.NotContains(t, counter.attempts, "b")
+
+	count, ok, err := counter.Increment(context.Background(), "e", 5, time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(2), count)
+
+	// an expired key is removed before the oldest one is evicted
+	counter = newMemoryLoginAttemptCounter(2)
+	_, _, err = counter.Increment(context.Background(), "a", 5, time.Minute)
+	require.NoError(t, err)
+	_, _, err = counter.Increment(context.Background(), "b", 5, time.Millisecond)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, _, err = counter.Increment(context.Background(), "c", 5, time.Minute)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"a", "c"}, trackedKeys(counter))
+}
+
+func trackedKeys(counter *MemoryLoginAttemptCounter) []string {
+	keys := make([]string, 0, len(counter.attempts))
+	for key := range counter.attempts {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestLoginGuardUsernameLockout(t *testing.T) {
+	guard := NewLoginGuard(NewMemoryLoginAttemptCounter(), LoginGuardConfig{
+		MaxFailures:     3,
+		LockoutDuration: time.Minute,
+	})
+	username := util.RandomOwner()
+
+	for i := 1; i <= 3; i++ {
+		attempt, err := guard.Begin(context.Background(), username, "127.0.0.1:1234")
+		require.NoError(t, err)
+
+		locked, err := attempt.Fail(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, i == 3, locked)
+	}
+
+	_, err := guard.Begin(context.Background(), username, "127.0.0.2:1234")
+	require.ErrorIs(t, err, ErrLoginLocked)
+
+	// other users are not affected
+	_, err = guard.Begin(context.Background(), util.RandomOwner(), "127.0.0.2:1234")
+	require.NoError(t, err)
+
+	require.NoError(t, guard.RecordSuccess(context.Background(), username))
+	_, err = guard.Begin(context.Background(), username, "127.0.0.2:1234")
+	require.NoError(t, err)
+}
+
+func TestLoginGuardIPLockout(t *testing.T) {
+	guard := NewLoginGuard(NewMemoryLoginAttemptCounter(), LoginGuardConfig{
+		MaxFailures:     10,
+		MaxIPFailures:   3,
+		LockoutDuration: time.Minute,
+	})
+
+	// one IP trying many usernames, each from a different port
+	for i := 0; i < 3; i++ {
+		attempt, err := guard.Begin(context.Background(), util.RandomOwner(), "10.0.0.1:"+util.RandomString(4))
+		require.NoError(t, err)
+		_, err = attempt.Fail(context.Background())
+		require.NoError(t, err)
+	}
+
+	username := util.RandomOwner()
+	_, err := guard.Begin(context.Background(), username, "10.0.0.1:80")
+	require.ErrorIs(t, err, ErrLoginLocked)
+
+	// the refused attempt is not held against the username
+	attempt, err := guard.Begin(context.Background(), username, "10.0.0.2:80")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), attempt.failures)
+}
+
+func TestLoginGuardReleaseAndSucceed(t *testing.T) {
+	guard := NewLoginGuard(NewMemoryLoginAttemptCounter(), LoginGuardConfig{
+		MaxFailures:     2,
+		MaxIPFailures:   2,
+		LockoutDuration: time.Minute,
+	})
+	username := util.RandomOwner()
+
+	// attempts that did not fail are taken back
+	for i := 0; i < 3; i++ {
+		attempt, err := guard.Begin(context.Background(), username, "10.0.0.1:80")
+		require.NoError(t, err)
+		require.NoError(t, attempt.Release(context.Background()))
+	}
+
+	attempt, err := guard.Begin(context.Background(), username, "10.0.0.1:80")
+	require.NoError(t, err)
+	_, err = attempt.Fail(context.Background())
+	require.NoError(t, err)
+	// releasing a failed attempt does nothing
+	require.NoError(t, attempt.Release(context.Background()))
+
+	// a success forgets the failures of the username, but not of the IP
+	attempt, err = guard.Begin(context.Background(), username, "10.0.0.1:80")
+	require.NoError(t, err)
+	require.NoError(t, attempt.Succeed(context.Background()))
+
+	attempt, err = guard.Begin(context.Background(), username, "10.0.0.2:80")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), attempt.failures)
+
+	attempt, err = guard.Begin(context.Background(), util.RandomOwner(), "10.0.0.1:80")
+	require.NoError(t, err)
+	_, err = attempt.Fail(context.Background())
+	require.NoError(t, err)
+
+	_, err = guard.Begin(context.Background(), util.RandomOwner(), "10.0.0.1:80")
+	require.ErrorIs(t, err, ErrLoginLocked)
+}
+
+func TestLoginGuardConcurrentAttempts(t *testing.T) {
+	guard := NewLoginGuard(NewMemoryLoginAttemptCounter(), LoginGuardConfig{
+		MaxFailures:     3,
+		LockoutDuration: time.Minute,
+	})
+	username := util.RandomOwner()
+
+	// guesses sent at once cannot get past the limit
+	var started atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := guard.Begin(context.Background(), username, ""); err == nil {
+				started.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int64(3), started.Load())
+}
+
+func TestLoginGuardMFAAttempts(t *testing.T) {
+	guard := NewLoginGuard(NewMemoryLoginAttemptCounter(), LoginGuardConfig{
+		MaxMFAAttempts: 3,
+	})
+	tokenID := uuid.New()
+	expiredAt := time.Now().Add(time.Minute)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, guard.RecordMFAAttempt(context.Background(), tokenID, expiredAt))
+	}
+	require.ErrorIs(t, guard.RecordMFAAttempt(context.Background(), tokenID, expiredAt), ErrMFAAttemptsExhausted)
+
+	// other tokens have their own attempts
+	require.NoError(t, guard.RecordMFAAttempt(context.Background(), uuid.New(), expiredAt))
+}
+
+func TestLoginGuardDelay(t *testing.T) {
+	guard := NewLoginGuard(NewMemoryLoginAttemptCounter(), LoginGuardConfig{
+		FailureDelay:    100 * time.Millisecond,
+		MaxFailureDelay: time.Second,
+	})
+
+	require.Equal(t, 100*time.Millisecond, guard.delay(1))
+	require.Equal(t, 200*time.Millisecond, guard.delay(2))
+	require.Equal(t, 800*time.Millisecond, guard.delay(4))
+	require.Equal(t, time.Second, guard.delay(5))
+	require.Equal(t, time.Second, guard.delay(100))
+
+	// a failed attempt waits before returning
+	attempt, err := guard.Begin(context.Background(), util.RandomOwner(), "")
+	require.NoError(t, err)
+	start := time.Now()
+	_, err = attempt.Fail(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	// unless the request is cancelled
+	attempt, err = guard.Begin(context.Background(), util.RandomOwner(), "")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = attempt.Fail(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+// PermissionChecker decides whether a role grants a permission
+type PermissionChecker interface {
+	// HasPermission reports whether the role grants the permission
+	HasPermission(ctx context.Context, role string, permission string) (bool, error)
+
+	// Forget drops any cached permissions
+	Forget()
+}
+
+// CachedPermissionChecker reads the permissions of every role from the database and keeps them in memory for ttl
+type CachedPermissionChecker struct {
+	store       db.Querier
+	ttl         time.Duration
+	mu          sync.Mutex
+	permissions map[string][]string
+	fetchedAt   time.Time
+}
+
+// NewCachedPermissionChecker creates a new PermissionChecker backed by the database.
+// A zero ttl disables caching.
+func NewCachedPermissionChecker(store db.Querier, ttl time.Duration) PermissionChecker {
+	return &CachedPermissionChecker{
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+// HasPermission reports whether the role grants the permission
+func (checker *CachedPermissionChecker) HasPermission(ctx context.Context, role string, permission string) (bool, error) {
+	permissions, err := checker.getPermissions(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return hasPermission(permissions[role], permission), nil
+}
+
+// Forget drops any cached permissions
+func (checker *CachedPermissionChecker) Forget() {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	checker.permissions = nil
+}
+
+func (checker *CachedPermissionChecker) getPermissions(ctx context.Context) (map[string][]string, error) {
+	checker.mu.Lock()
+	permissions, fetchedAt := checker.permissions, checker.fetchedAt
+	checker.mu.Unlock()
+
+	if permissions != nil && time.Since(fetchedAt) < checker.ttl {
+		return permissions, nil
+	}
+
+	rows, err := checker.store.ListRolePermissions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role permissions: %w", err)
+	}
+
+	permissions = make(map[string][]string)
+	for _, row := range rows {
+		permissions[row.Role] = append(permissions[row.Role], row.Permission)
+	}
+
+	if checker.ttl > 0 {
+		checker.mu.Lock()
+		checker.permissions = permissions
+		checker.fetchedAt = time.Now()
+		checker.mu.Unlock()
+	}
+
+	return permissions, nil
+}
+
+// StaticPermissionChecker grants a fixed set of permissions to each role
+type StaticPermissionChecker struct {
+	permissions map[string][]string
+}
+
+// NewStaticPermissionChecker creates a new PermissionChecker that does not need a database
+func NewStaticPermissionChecker(permissions map[string][]string) PermissionChecker {
+	return &StaticPermissionChecker{
+		permissions: permissions,
+	}
+}
+
+// HasPermission reports whether the role grants the permission
+func (checker *StaticPermissionChecker) HasPermission(ctx context.Context, role string, permission string) (bool, error) {
+	return hasPermission(checker.permissions[role], permission), nil
+}
+
+// Forget does nothing, the permissions never change
+func (checker *StaticPermissionChecker) Forget() {}
+
+func hasPermission(granted []string, permission string) bool {
+	for _, g := range granted {
+		if util.GrantsPermission(g, permission) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func defaultRolePermissionRows() []db.RolePermission {
+	var rows []db.RolePermission
+	for role, permissions := range util.DefaultRolePermissions {
+		for _, permission := range permissions {
+			rows = append(rows, db.RolePermission{Role: role, Permission: permission})
+		}
+	}
+	return rows
+}
+
+func TestHasPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	checker := NewCachedPermissionChecker(store, time.Minute)
+
+	// every check is served from a single query
+	store.EXPECT().ListRolePermissions(gomock.Any()).Times(1).Return(defaultRolePermissionRows(), nil)
+
+	testCases := []struct {
+		role       string
+		permission string
+		granted    bool
+	}{
+		{util.DepositorRole, util.PermissionAccountsReadOwn, true},
+		{util.DepositorRole, util.PermissionAccountsReadAny, false},
+		{util.DepositorRole, util.PermissionRolesAssignAny, false},
+		{util.BankerRole, util.PermissionAccountsReadOwn, true},
+		{util.BankerRole, util.PermissionAccountsReadAny, true},
+		{util.BankerRole, util.PermissionUsersUpdateOwn, true},
+		{util.BankerRole, util.PermissionRolesAssignAny, true},
+		{"unknown", util.PermissionAccountsReadOwn, false},
+	}
+
+	for _, tc := range testCases {
+		granted, err := checker.HasPermission(context.Background(), tc.role, tc.permission)
+		require.NoError(t, err)
+		require.Equal(t, tc.granted, granted, "%s %s", tc.role, tc.permission)
+	}
+
+	// forgetting the permissions picks up the changes
+	store.EXPECT().
+		ListRolePermissions(gomock.Any()).
+		Times(1).
+		Return([]db.RolePermission{{Role: util.DepositorRole, Permission: util.PermissionAccountsReadAny}}, nil)
+	checker.Forget()
+
+	granted, err := checker.HasPermission(context.Background(), util.DepositorRole, util.PermissionAccountsReadAny)
+	require.NoError(t, err)
+	require.True(t, granted)
+}
+
+func TestHasPermissionNoCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	checker := NewCachedPermissionChecker(store, 0)
+
+	store.EXPECT().ListRolePermissions(gomock.Any()).Times(2).Return(defaultRolePermissionRows(), nil)
+	for i := 0; i < 2; i++ {
+		granted, err := checker.HasPermission(context.Background(), util.BankerRole, util.PermissionRolesReadAny)
+		require.NoError(t, err)
+		require.True(t, granted)
+	}
+
+	store.EXPECT().ListRolePermissions(gomock.Any()).Times(1).Return(nil, errors.New("db down"))
+	granted, err := checker.HasPermission(context.Background(), util.BankerRole, util.PermissionRolesReadAny)
+	require.Error(t, err)
+	require.False(t, granted)
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretCipher(t *testing.T) {
+	c, err := NewSecretCipher(util.RandomString(32))
+	require.NoError(t, err)
+
+	secret := util.RandomString(32)
+	encrypted, err := c.Encrypt(secret)
+	require.NoError(t, err)
+	require.NotContains(t, encrypted, secret)
+
+	decrypted, err := c.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, secret, decrypted)
+
+	// a different key cannot read it
+	other, err := NewSecretCipher(util.RandomString(32))
+	require.NoError(t, err)
+
+	_, err = other.Decrypt(encrypted)
+	require.ErrorIs(t, err, ErrInvalidCiphertext)
+
+	_, err = c.Decrypt("invalid")
+	require.ErrorIs(t, err, ErrInvalidCiphertext)
+
+	_, err = NewSecretCipher(util.RandomString(16))
+	require.Error(t, err)
+}
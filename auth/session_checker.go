@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/token"
+)
+
+// Different types of error returned by the CheckSession function
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrSessionBlocked  = errors.New("session is blocked")
+	ErrPasswordChanged = errors.New("token was issued before the last password change")
+)
+
+// maxCachedSessions bounds the memory used by the cache
+const maxCachedSessions = 10000
+
+// SessionChecker decides whether a verified access token is still honoured
+type SessionChecker interface {
+	// CheckSession returns an error if the token's session was revoked
+	CheckSession(ctx context.Context, payload *token.Payload) error
+
+	// ForgetSession drops any cached state of the session
+	ForgetSession(sessionID uuid.UUID)
+
+	// ForgetUser drops any cached state of the user's sessions
+	ForgetUser(username string)
+}
+
+type sessionState struct {
+	username          string
+	isBlocked         bool
+	passwordChangedAt time.Time
+	fetchedAt         time.Time
+}
+
+// CachedSessionChecker reads session state from the database and keeps it in memory for ttl
+type CachedSessionChecker struct {
+	store    db.Querier
+	ttl      time.Duration
+	mu       sync.Mutex
+	sessions map[uuid.UUID]sessionState
+}
+
+// NewCachedSessionChecker creates a new SessionChecker backed by the database.
+// A zero ttl disables caching.
+func NewCachedSessionChecker(store db.Querier, ttl time.Duration) SessionChecker {
+	return &CachedSessionChecker{
+		store:    store,
+		ttl:      ttl,
+		sessions: make(map[uuid.UUID]sessionState),
+	}
+}
+
+// CheckSession rejects tokens whose session is blocked or which predate a password change
+func (checker *CachedSessionChecker) CheckSession(ctx context.Context, payload *token.Payload) error {
+	state, err := checker.getSessionState(ctx, payload.SessionID)
+	if err != nil {
+		return err
+	}
+
+	if state.username != payload.Username {
+		return ErrSessionNotFound
+	}
+
+	if state.isBlocked {
+		return ErrSessionBlocked
+	}
+
+	if payload.IssuedAt.Before(state.passwordChangedAt) {
+		return ErrPasswordChanged
+	}
+
+	return nil
+}
+
+// ForgetSession drops any cached state of the session
+func (checker *CachedSessionChecker) ForgetSession(sessionID uuid.UUID) {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	delete(checker.sessions, sessionID)
+}
+
+// ForgetUser drops any cached state of the user's sessions
+func (checker *CachedSessionChecker) ForgetUser(username string) {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	for id, state := range checker.sessions {
+		if state.username == username {
+			delete(checker.sessions, id)
+		}
+	}
+}
+
+func (checker *CachedSessionChecker) getSessionState(ctx context.Context, sessionID uuid.UUID) (sessionState, error) {
+	checker.mu.Lock()
+	state, ok := checker.sessions[sessionID]
+	checker.mu.Unlock()
+
+	if ok && time.Since(state.fetchedAt) < checker.ttl {
+		return state, nil
+	}
+
+	row, err := checker.store.GetSessionAuthState(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return sessionState{}, ErrSessionNotFound
+		}
+		return sessionState{}, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	state = sessionState{
+		username:          row.Username,
+		isBlocked:         row.IsBlocked,
+		passwordChangedAt: row.PasswordChangedAt,
+		fetchedAt:         time.Now(),
+	}
+
+	if checker.ttl > 0 {
+		checker.mu.Lock()
+		checker.evictExpired()
+		if len(checker.sessions) < maxCachedSessions {
+			checker.sessions[sessionID] = state
+		}
+		checker.mu.Unlock()
+	}
+
+	return state, nil
+}
+
+// evictExpired makes room in a full cache; the caller must hold mu
+func (checker *CachedSessionChecker) evictExpired() {
+	if len(checker.sessions) < maxCachedSessions {
+		return
+	}
+
+	for id, state := range checker.sessions {
+		if time.Since(state.fetchedAt) >= checker.ttl {
+			delete(checker.sessions, id)
+		}
+	}
+}
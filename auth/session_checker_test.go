@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomAccessPayload(t *testing.T) *token.Payload {
+	payload, err := token.NewPayload(util.RandomOwner(), util.DepositorRole, uuid.New(), time.Minute, token.TokenTypeAccessToken)
+	require.NoError(t, err)
+	return payload
+}
+
+func TestCheckSession(t *testing.T) {
+	payload := randomAccessPayload(t)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{
+						Username:          payload.Username,
+						PasswordChangedAt: payload.IssuedAt.Add(-time.Hour),
+					}, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Blocked",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{
+						Username:  payload.Username,
+						IsBlocked: true,
+					}, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrSessionBlocked)
+			},
+		},
+		{
+			name: "PasswordChanged",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{
+						Username:          payload.Username,
+						PasswordChangedAt: payload.IssuedAt.Add(time.Second),
+					}, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrPasswordChanged)
+			},
+		},
+		{
+			name: "OtherUser",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{Username: util.RandomOwner()}, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrSessionNotFound)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{}, db.ErrRecordNotFound)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrSessionNotFound)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{}, errors.New("connection lost"))
+			},
+			checkError: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.NotErrorIs(t, err, ErrSessionNotFound)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			checker := NewCachedSessionChecker(store, time.Minute)
+			err := checker.CheckSession(context.Background(), payload)
+			tc.checkError(t, err)
+		})
+	}
+}
+
+func TestCheckSessionCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	payload := randomAccessPayload(t)
+	state := db.GetSessionAuthStateRow{Username: payload.Username}
+
+	checker := NewCachedSessionChecker(store, time.Minute)
+
+	// repeated checks are served from memory
+	store.EXPECT().GetSessionAuthState(gomock.Any(), gomock.Eq(payload.SessionID)).Times(1).Return(state, nil)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, checker.CheckSession(context.Background(), payload))
+	}
+
+	// forgetting the session picks up its new state
+	state.IsBlocked = true
+	store.EXPECT().GetSessionAuthState(gomock.Any(), gomock.Eq(payload.SessionID)).Times(1).Return(state, nil)
+	checker.ForgetSession(payload.SessionID)
+	require.ErrorIs(t, checker.CheckSession(context.Background(), payload), ErrSessionBlocked)
+
+	// and so does forgetting the user
+	state.IsBlocked = false
+	store.EXPECT().GetSessionAuthState(gomock.Any(), gomock.Eq(payload.SessionID)).Times(1).Return(state, nil)
+	checker.ForgetUser(payload.Username)
+	require.NoError(t, checker.CheckSession(context.Background(), payload))
+}
+
+func TestCheckSessionNoCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	payload := randomAccessPayload(t)
+
+	checker := NewCachedSessionChecker(store, 0)
+
+	store.EXPECT().
+		GetSessionAuthState(gomock.Any(), gomock.Eq(payload.SessionID)).
+		Times(2).
+		Return(db.GetSessionAuthStateRow{Username: payload.Username}, nil)
+	require.NoError(t, checker.CheckSession(context.Background(), payload))
+	require.NoError(t, checker.CheckSession(context.Background(), payload))
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateWebhookSecret(t *testing.T) {
+	secret1, err := GenerateWebhookSecret()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret1, webhookSecretPrefix))
+	require.Len(t, secret1, len(webhookSecretPrefix)+2*webhookSecretSize)
+
+	secret2, err := GenerateWebhookSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, secret1, secret2)
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	secret := "whsec_test"
+	timestamp := time.Unix(1700000000, 0)
+	body := []byte(`{"type":"transfer.created"}`)
+
+	signature := SignWebhookPayload(secret, timestamp, body)
+	require.Equal(t, "sha256=eae3037eeec8b0fad1a9a7ef8d6d7ba0b023c574d3353b32ab8188828bdc53dc", signature)
+	require.True(t, VerifyWebhookSignature(secret, timestamp, body, signature))
+
+	require.False(t, VerifyWebhookSignature("whsec_other", timestamp, body, signature))
+	require.False(t, VerifyWebhookSignature(secret, timestamp.Add(time.Second), body, signature))
+	require.False(t, VerifyWebhookSignature(secret, timestamp, []byte(`{"type":"account.created"}`), signature))
+	require.False(t, VerifyWebhookSignature(secret, timestamp, body, strings.TrimPrefix(signature, "sha256=")))
+}
@@ -0,0 +1,26 @@
+ALTER TABLE "entries" ADD COLUMN "balance" bigint;
+
+-- walk back from the current account balance to get the balance after each existing entry
+UPDATE "entries" SET "balance" = "running"."balance"
+FROM (
+  SELECT
+    "entries"."id",
+    "accounts"."balance" - COALESCE(SUM("entries"."amount") OVER (
+      PARTITION BY "entries"."account_id"
+      ORDER BY "entries"."id" DESC
+      ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING
+    ), 0) AS "balance"
+  FROM "entries"
+  JOIN "accounts" ON "accounts"."id" = "entries"."account_id"
+) AS "running"
+WHERE "entries"."id" = "running"."id";
+
+ALTER TABLE "entries" ALTER COLUMN "balance" SET NOT NULL;
+
+COMMENT ON COLUMN "entries"."balance" IS 'account balance right after this entry';
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
+
+CREATE INDEX ON "transfers" ("to_account_id", "created_at");
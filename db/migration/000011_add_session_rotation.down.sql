@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "sessions_username_idx";
+
+DROP INDEX IF EXISTS "sessions_family_id_idx";
+
+ALTER TABLE "sessions" DROP COLUMN "is_rotated";
+
+ALTER TABLE "sessions" DROP COLUMN "parent_id";
+
+ALTER TABLE "sessions" DROP COLUMN "family_id";
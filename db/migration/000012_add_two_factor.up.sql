@@ -0,0 +1,13 @@
+ALTER TABLE "users" ADD COLUMN "totp_secret" varchar;
+
+ALTER TABLE "users" ADD COLUMN "is_totp_enabled" boolean NOT NULL DEFAULT false;
+
+ALTER TABLE "users" ADD COLUMN "totp_last_step" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "users" ADD COLUMN "recovery_codes" varchar[] NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN "users"."totp_secret" IS 'encrypted with TOTP_ENCRYPTION_KEY';
+
+COMMENT ON COLUMN "users"."totp_last_step" IS 'time step of the last accepted code, to refuse replays';
+
+COMMENT ON COLUMN "users"."recovery_codes" IS 'sha256 hashes of the unused recovery codes';
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "verify_emails_username_created_at_idx";
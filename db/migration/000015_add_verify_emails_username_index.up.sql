@@ -0,0 +1 @@
+CREATE INDEX ON "verify_emails" ("username", "created_at");
@@ -0,0 +1,35 @@
+CREATE TABLE "roles" (
+  "name" varchar PRIMARY KEY,
+  "description" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "role_permissions" (
+  "role" varchar NOT NULL,
+  "permission" varchar NOT NULL,
+  PRIMARY KEY ("role", "permission")
+);
+
+ALTER TABLE "role_permissions" ADD FOREIGN KEY ("role") REFERENCES "roles" ("name");
+
+COMMENT ON COLUMN "role_permissions"."permission" IS 'resource:action:scope, an any scope also grants the own scope';
+
+INSERT INTO "roles" ("name", "description") VALUES
+  ('depositor', 'Customer managing their own accounts'),
+  ('banker', 'Bank staff with access to every customer');
+
+INSERT INTO "role_permissions" ("role", "permission") VALUES
+  ('depositor', 'accounts:create:own'),
+  ('depositor', 'accounts:read:own'),
+  ('depositor', 'transfers:create:own'),
+  ('depositor', 'users:update:own'),
+  ('depositor', 'sessions:manage:own'),
+  ('banker', 'accounts:create:own'),
+  ('banker', 'accounts:read:any'),
+  ('banker', 'transfers:create:own'),
+  ('banker', 'users:update:any'),
+  ('banker', 'sessions:manage:own'),
+  ('banker', 'roles:read:any'),
+  ('banker', 'roles:assign:any');
+
+ALTER TABLE "users" ADD FOREIGN KEY ("role") REFERENCES "roles" ("name");
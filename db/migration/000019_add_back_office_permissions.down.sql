@@ -0,0 +1,2 @@
+DELETE FROM "role_permissions"
+WHERE "role" = 'banker' AND "permission" IN ('users:read:any', 'accounts:freeze:any', 'accounts:adjust:any');
@@ -0,0 +1,4 @@
+INSERT INTO "role_permissions" ("role", "permission") VALUES
+  ('banker', 'users:read:any'),
+  ('banker', 'accounts:freeze:any'),
+  ('banker', 'accounts:adjust:any');
@@ -0,0 +1,13 @@
+DELETE FROM "role_permissions"
+WHERE ("role" = 'depositor' AND "permission" = 'accounts:close:own')
+  OR ("role" = 'banker' AND "permission" IN ('accounts:close:any', 'accounts:reopen:any'));
+
+DROP TABLE IF EXISTS "account_status_changes";
+
+UPDATE "accounts" SET "status" = 'frozen' WHERE "status" = 'closed';
+
+ALTER TABLE IF EXISTS "accounts" DROP CONSTRAINT IF EXISTS "account_status_valid";
+
+ALTER TABLE IF EXISTS "accounts" ADD CONSTRAINT "account_status_valid" CHECK ("status" IN ('active', 'frozen'));
+
+COMMENT ON COLUMN "accounts"."status" IS 'no money moves in or out of a frozen account';
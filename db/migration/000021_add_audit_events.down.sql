@@ -0,0 +1,6 @@
+DELETE FROM "role_permissions"
+WHERE "role" = 'banker' AND "permission" = 'audit:read:any';
+
+DROP TABLE IF EXISTS "audit_events";
+
+DROP FUNCTION IF EXISTS "audit_events_append_only";
@@ -0,0 +1,41 @@
+CREATE TABLE "audit_events" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "target_type" varchar NOT NULL,
+  "target_id" varchar NOT NULL,
+  "before" jsonb,
+  "after" jsonb,
+  "client_ip" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "audit_events" ("created_at", "id");
+
+CREATE INDEX ON "audit_events" ("actor", "created_at", "id");
+
+CREATE INDEX ON "audit_events" ("target_type", "target_id", "created_at", "id");
+
+COMMENT ON COLUMN "audit_events"."actor" IS 'username of who made the change, empty when unknown';
+
+COMMENT ON COLUMN "audit_events"."before" IS 'changed fields before the change, secrets are redacted';
+
+COMMENT ON COLUMN "audit_events"."after" IS 'changed fields after the change, secrets are redacted';
+
+CREATE FUNCTION "audit_events_append_only"() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'audit events cannot be changed or deleted';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_events_no_update_or_delete"
+BEFORE UPDATE OR DELETE ON "audit_events"
+FOR EACH ROW EXECUTE FUNCTION "audit_events_append_only"();
+
+CREATE TRIGGER "audit_events_no_truncate"
+BEFORE TRUNCATE ON "audit_events"
+FOR EACH STATEMENT EXECUTE FUNCTION "audit_events_append_only"();
+
+INSERT INTO "role_permissions" ("role", "permission") VALUES
+  ('banker', 'audit:read:any');
@@ -0,0 +1,20 @@
+CREATE TABLE "outbox_tasks" (
+  "id" bigserial PRIMARY KEY,
+  "task_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "queue" varchar NOT NULL,
+  "max_retry" int NOT NULL,
+  "process_at" timestamptz NOT NULL DEFAULT (now()),
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz
+);
+
+CREATE INDEX ON "outbox_tasks" ("id") WHERE "sent_at" IS NULL;
+
+COMMENT ON COLUMN "outbox_tasks"."process_at" IS 'the worker must not process the task before this time';
+
+COMMENT ON COLUMN "outbox_tasks"."attempts" IS 'failed attempts to publish the task';
+
+COMMENT ON COLUMN "outbox_tasks"."sent_at" IS 'when the relay published the task, null while pending';
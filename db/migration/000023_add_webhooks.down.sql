@@ -0,0 +1,5 @@
+DELETE FROM "role_permissions" WHERE "permission" IN ('webhooks:manage:own', 'webhooks:manage:any');
+
+DROP TABLE IF EXISTS "webhook_deliveries";
+
+DROP TABLE IF EXISTS "webhook_subscriptions";
@@ -0,0 +1,39 @@
+CREATE TABLE "webhook_subscriptions" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "url" varchar NOT NULL,
+  "event_types" varchar[] NOT NULL,
+  "encrypted_secret" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+  "id" bigserial PRIMARY KEY,
+  "subscription_id" bigint NOT NULL,
+  "event_id" uuid NOT NULL,
+  "event_type" varchar NOT NULL,
+  "attempt" int NOT NULL,
+  "status_code" int NOT NULL,
+  "error" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "webhook_subscriptions" ("owner");
+
+CREATE INDEX ON "webhook_deliveries" ("subscription_id", "created_at", "id");
+
+COMMENT ON COLUMN "webhook_subscriptions"."owner" IS 'events about this user and their accounts are delivered';
+
+COMMENT ON COLUMN "webhook_subscriptions"."encrypted_secret" IS 'key of the HMAC-SHA256 signature, encrypted with WEBHOOK_ENCRYPTION_KEY';
+
+COMMENT ON COLUMN "webhook_deliveries"."status_code" IS 'HTTP status of the response, 0 when there was none';
+
+COMMENT ON COLUMN "webhook_deliveries"."error" IS 'empty when the webhook answered with a 2xx status';
+
+ALTER TABLE "webhook_subscriptions" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("subscription_id") REFERENCES "webhook_subscriptions" ("id") ON DELETE CASCADE;
+
+INSERT INTO "role_permissions" ("role", "permission") VALUES
+  ('depositor', 'webhooks:manage:own'),
+  ('banker', 'webhooks:manage:any');
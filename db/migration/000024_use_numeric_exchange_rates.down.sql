@@ -0,0 +1,3 @@
+ALTER TABLE "transfers" ALTER COLUMN "exchange_rate" TYPE double precision USING "exchange_rate"::double precision;
+
+ALTER TABLE "exchange_rates" ALTER COLUMN "rate" TYPE double precision USING "rate"::double precision;
@@ -0,0 +1,3 @@
+ALTER TABLE "exchange_rates" ALTER COLUMN "rate" TYPE numeric(20, 10) USING round("rate"::numeric, 10);
+
+ALTER TABLE "transfers" ALTER COLUMN "exchange_rate" TYPE numeric(20, 10) USING round("exchange_rate"::numeric, 10);
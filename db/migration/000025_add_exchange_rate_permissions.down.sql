@@ -0,0 +1,2 @@
+DELETE FROM "role_permissions"
+WHERE "role" = 'banker' AND "permission" = 'exchange_rates:manage:any';
@@ -0,0 +1,2 @@
+INSERT INTO "role_permissions" ("role", "permission") VALUES
+  ('banker', 'exchange_rates:manage:any');
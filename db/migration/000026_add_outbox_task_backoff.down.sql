@@ -0,0 +1 @@
+ALTER TABLE "outbox_tasks" DROP COLUMN "next_attempt_at";
@@ -0,0 +1,3 @@
+ALTER TABLE "outbox_tasks" ADD COLUMN "next_attempt_at" timestamptz NOT NULL DEFAULT (now());
+
+COMMENT ON COLUMN "outbox_tasks"."next_attempt_at" IS 'the relay must not publish the task before this time, pushed back while it is claimed and after each failure';
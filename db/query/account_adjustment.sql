@@ -0,0 +1,14 @@
+-- name: CreateAccountAdjustment :one
+INSERT INTO account_adjustments (
+  account_id,
+  entry_id,
+  amount,
+  reason,
+  created_by
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetAccountAdjustment :one
+SELECT * FROM account_adjustments
+WHERE id = $1 LIMIT 1;
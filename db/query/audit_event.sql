@@ -0,0 +1,26 @@
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+  actor,
+  action,
+  target_type,
+  target_id,
+  before,
+  after,
+  client_ip,
+  user_agent
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: ListAuditEvents :many
+SELECT * FROM audit_events
+WHERE
+    (sqlc.narg(actor)::varchar IS NULL OR actor = sqlc.narg(actor)) AND
+    (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action)) AND
+    (sqlc.narg(target_type)::varchar IS NULL OR target_type = sqlc.narg(target_type)) AND
+    (sqlc.narg(target_id)::varchar IS NULL OR target_id = sqlc.narg(target_id)) AND
+    created_at >= sqlc.arg(start_time) AND
+    created_at < sqlc.arg(end_time) AND
+    (created_at, id) > (sqlc.arg(after_created_at)::timestamptz, sqlc.arg(after_id)::bigint)
+ORDER BY created_at, id
+LIMIT sqlc.arg('limit');
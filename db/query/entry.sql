@@ -1,9 +1,10 @@
 -- name: CreateEntry :one
 INSERT INTO entries (
   account_id,
-  amount
+  amount,
+  balance
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 ) RETURNING *;
 
 -- name: GetEntry :one
@@ -12,7 +13,11 @@ WHERE id = $1 LIMIT 1;
 
 -- name: ListEntries :many
 SELECT * FROM entries
-WHERE account_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3;
+WHERE
+    account_id = sqlc.arg(account_id) AND
+    created_at >= sqlc.arg(start_time) AND
+    created_at < sqlc.arg(end_time) AND
+    ((sqlc.arg(incoming)::bool AND amount > 0) OR (sqlc.arg(outgoing)::bool AND amount < 0)) AND
+    (created_at, id) > (sqlc.arg(after_created_at)::timestamptz, sqlc.arg(after_id)::bigint)
+ORDER BY created_at, id
+LIMIT sqlc.arg('limit');
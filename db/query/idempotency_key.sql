@@ -0,0 +1,16 @@
+-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys (
+  from_account_id,
+  idempotency_key,
+  to_account_id,
+  amount,
+  transfer_id,
+  result
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE from_account_id = $1 AND idempotency_key = $2
+LIMIT 1;
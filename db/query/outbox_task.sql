@@ -0,0 +1,41 @@
+-- name: CreateOutboxTask :one
+INSERT INTO outbox_tasks (
+  task_type,
+  payload,
+  queue,
+  max_retry,
+  process_at
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ClaimOutboxTasks :many
+UPDATE outbox_tasks
+SET next_attempt_at = sqlc.arg(claimed_until)
+WHERE id IN (
+  SELECT pending.id FROM outbox_tasks AS pending
+  WHERE pending.sent_at IS NULL
+    AND pending.next_attempt_at <= now()
+    AND pending.attempts < sqlc.arg(max_attempts)
+  ORDER BY pending.id
+  LIMIT sqlc.arg(task_limit)
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkOutboxTaskSent :exec
+UPDATE outbox_tasks
+SET sent_at = now()
+WHERE id = $1;
+
+-- name: RecordOutboxTaskFailure :exec
+UPDATE outbox_tasks
+SET
+  attempts = attempts + 1,
+  last_error = $2,
+  next_attempt_at = $3
+WHERE id = $1;
+
+-- name: GetOutboxTask :one
+SELECT * FROM outbox_tasks
+WHERE id = $1 LIMIT 1;
@@ -0,0 +1,32 @@
+-- name: CreatePasswordReset :one
+INSERT INTO password_resets (
+    username,
+    secret_code
+) VALUES (
+    $1, $2
+) RETURNING *;
+
+-- name: UpdatePasswordReset :one
+UPDATE password_resets
+SET
+    is_used = TRUE
+WHERE
+    id = @id
+    AND secret_code = @secret_code
+    AND is_used = FALSE
+    AND expired_at > now()
+RETURNING *;
+
+-- name: InvalidateUserPasswordResets :execrows
+UPDATE password_resets
+SET
+    is_used = TRUE
+WHERE
+    username = @username
+    AND is_used = FALSE;
+
+-- name: GetLatestPasswordReset :one
+SELECT * FROM password_resets
+WHERE username = $1
+ORDER BY created_at DESC
+LIMIT 1;
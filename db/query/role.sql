@@ -0,0 +1,15 @@
+-- name: ListRoles :many
+SELECT * FROM roles
+ORDER BY name;
+
+-- name: ListRolePermissions :many
+SELECT * FROM role_permissions
+ORDER BY role, permission;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET
+    role = @role
+WHERE
+    username = @username
+RETURNING *;
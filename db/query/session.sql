@@ -6,11 +6,67 @@ INSERT INTO sessions (
   user_agent,
   client_ip,
   is_blocked,
-  expires_at
+  expires_at,
+  family_id,
+  parent_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7
+  sqlc.arg(id),
+  sqlc.arg(username),
+  sqlc.arg(refresh_token),
+  sqlc.arg(user_agent),
+  sqlc.arg(client_ip),
+  sqlc.arg(is_blocked),
+  sqlc.arg(expires_at),
+  COALESCE(sqlc.narg(family_id)::uuid, sqlc.arg(id)),
+  sqlc.narg(parent_id)
 ) RETURNING *;
 
 -- name: GetSession :one
 SELECT * FROM sessions
 WHERE id = $1 LIMIT 1;
+
+-- name: GetSessionAuthState :one
+SELECT s.username, s.is_blocked, u.password_changed_at
+FROM sessions AS s
+JOIN users AS u ON u.username = s.username
+WHERE s.id = $1 LIMIT 1;
+
+-- name: GetSessionForUpdate :one
+SELECT * FROM sessions
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListSessions :many
+SELECT * FROM sessions
+WHERE
+    username = $1 AND
+    is_blocked = false AND
+    is_rotated = false AND
+    expires_at > now()
+ORDER BY created_at DESC;
+
+-- name: BlockSession :one
+UPDATE sessions
+SET is_blocked = true
+WHERE id = $1
+RETURNING *;
+
+-- name: BlockUserSessions :execrows
+UPDATE sessions
+SET is_blocked = true
+WHERE
+    username = $1 AND
+    is_blocked = false;
+
+-- name: RotateSession :one
+UPDATE sessions
+SET is_rotated = true
+WHERE id = $1
+RETURNING *;
+
+-- name: BlockSessionFamily :execrows
+UPDATE sessions
+SET is_blocked = true
+WHERE
+    family_id = $1 AND
+    is_blocked = false;
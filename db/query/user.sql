@@ -12,6 +12,10 @@ INSERT INTO users (
 SELECT * FROM users
 WHERE username = $1 LIMIT 1;
 
+-- name: GetUserByEmail :one
+SELECT * FROM users
+WHERE email = $1 LIMIT 1;
+
 -- name: UpdateUser :one
 UPDATE users
 SET
@@ -19,7 +23,83 @@ SET
   password_changed_at = COALESCE(sqlc.narg(password_changed_at), password_changed_at),
   full_name = COALESCE(sqlc.narg(full_name), full_name),
   email = COALESCE(sqlc.narg(email), email),
+  pending_email = NULLIF(COALESCE(sqlc.narg(pending_email), pending_email), COALESCE(sqlc.narg(email), email)),
   is_email_verified = COALESCE(sqlc.narg(is_email_verified), is_email_verified)
 WHERE
   username = sqlc.arg(username)
 RETURNING *;
+
+-- name: RehashUserPassword :execrows
+UPDATE users
+SET
+  hashed_password = sqlc.arg(new_hashed_password)
+WHERE
+  username = sqlc.arg(username)
+  AND hashed_password = sqlc.arg(old_hashed_password);
+
+-- name: ConfirmUserPendingEmail :one
+UPDATE users
+SET
+  email = pending_email,
+  pending_email = NULL,
+  is_email_verified = TRUE
+WHERE
+  username = sqlc.arg(username)
+  AND pending_email = sqlc.arg(pending_email)::varchar
+RETURNING *;
+
+-- name: SetUserTotpSecret :one
+UPDATE users
+SET
+  totp_secret = sqlc.arg(totp_secret)::varchar,
+  recovery_codes = '{}'
+WHERE
+  username = sqlc.arg(username)
+  AND is_totp_enabled = false
+RETURNING *;
+
+-- name: EnableUserTotp :one
+UPDATE users
+SET
+  is_totp_enabled = true,
+  totp_last_step = sqlc.arg(totp_last_step),
+  recovery_codes = sqlc.arg(recovery_codes)::varchar[]
+WHERE
+  username = sqlc.arg(username)
+  AND totp_secret IS NOT NULL
+RETURNING *;
+
+-- name: DisableUserTotp :one
+UPDATE users
+SET
+  totp_secret = NULL,
+  is_totp_enabled = false,
+  totp_last_step = 0,
+  recovery_codes = '{}'
+WHERE
+  username = sqlc.arg(username)
+RETURNING *;
+
+-- name: UseUserTotpStep :execrows
+UPDATE users
+SET
+  totp_last_step = sqlc.arg(totp_last_step)
+WHERE
+  username = sqlc.arg(username)
+  AND totp_last_step < sqlc.arg(totp_last_step);
+
+-- name: UseUserRecoveryCode :execrows
+UPDATE users
+SET
+  recovery_codes = array_remove(recovery_codes, sqlc.arg(recovery_code)::varchar)
+WHERE
+  username = sqlc.arg(username)
+  AND sqlc.arg(recovery_code)::varchar = ANY(recovery_codes);
+
+-- name: SearchUsers :many
+SELECT * FROM users
+WHERE
+    (username ILIKE sqlc.arg(pattern) OR email ILIKE sqlc.arg(pattern) OR full_name ILIKE sqlc.arg(pattern)) AND
+    username > sqlc.arg(after_username)
+ORDER BY username
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// AccountActivityChannel is the Postgres channel notified when money moves in or out of an account.
+// Postgres delivers the notifications when the transaction commits, and drops them if it rolls back.
+const AccountActivityChannel = "account_activity"
+
+// AccountActivity is the payload of a notification on AccountActivityChannel
+type AccountActivity struct {
+	Account Account `json:"account"`
+	Entry   Entry   `json:"entry"`
+}
+
+// recordAccountActivity notifies the listeners of AccountActivityChannel about a new entry of the account,
+// within the transaction of q
+func recordAccountActivity(ctx context.Context, q *Queries, account Account, entry Entry) error {
+	payload, err := json.Marshal(AccountActivity{
+		Account: account,
+		Entry:   entry,
+	})
+	if err != nil {
+		return err
+	}
+
+	return q.NotifyAccountActivity(ctx, NotifyAccountActivityParams{
+		Channel: AccountActivityChannel,
+		Payload: string(payload),
+	})
+}
+
+// ListenAccountActivity listens on AccountActivityChannel and calls handle with every notification,
+// until ctx is done or the connection fails. The connection must not be used for anything else meanwhile.
+func ListenAccountActivity(ctx context.Context, conn *pgx.Conn, handle func(AccountActivity)) error {
+	_, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{AccountActivityChannel}.Sanitize())
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", AccountActivityChannel, err)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var activity AccountActivity
+		err = json.Unmarshal([]byte(notification.Payload), &activity)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal account activity: %w", err)
+		}
+
+		handle(activity)
+	}
+}
@@ -0,0 +1,24 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.22.0
+// source: account_activity.sql
+
+package db
+
+import (
+	"context"
+)
+
+const notifyAccountActivity = `-- name: NotifyAccountActivity :exec
+SELECT pg_notify($1::text, $2::text)
+`
+
+type NotifyAccountActivityParams struct {
+	Channel string `json:"channel"`
+	Payload string `json:"payload"`
+}
+
+func (q *Queries) NotifyAccountActivity(ctx context.Context, arg NotifyAccountActivityParams) error {
+	_, err := q.db.Exec(ctx, notifyAccountActivity, arg.Channel, arg.Payload)
+	return err
+}
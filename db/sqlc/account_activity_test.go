@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// listenAccountActivity collects the account activity notified until the test ends
+func listenAccountActivity(t *testing.T) <-chan AccountActivity {
+	poolConn, err := testStore.(*SQLStore).connPool.Acquire(context.Background())
+	require.NoError(t, err)
+
+	// the listening connection must not go back to the pool
+	conn := poolConn.Hijack()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	activities := make(chan AccountActivity, 100)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ListenAccountActivity(ctx, conn, func(activity AccountActivity) {
+			activities <- activity
+		})
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		conn.Close(context.Background())
+	})
+
+	// LISTEN is in place once a notification sent after it comes back
+	_, err = testStore.(*SQLStore).connPool.Exec(context.Background(),
+		"SELECT pg_notify($1, $2)", AccountActivityChannel, `{"account": {"id": 0}}`)
+	require.NoError(t, err)
+	activity := nextAccountActivity(t, activities)
+	require.Zero(t, activity.Account.ID)
+
+	return activities
+}
+
+func nextAccountActivity(t *testing.T, activities <-chan AccountActivity) AccountActivity {
+	select {
+	case activity := <-activities:
+		return activity
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no account activity")
+		return AccountActivity{}
+	}
+}
+
+func TestTransferTxNotifiesAccountActivity(t *testing.T) {
+	activities := listenAccountActivity(t)
+
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	// other tests may move money at the same time
+	received := make(map[int64]AccountActivity)
+	for len(received) < 2 {
+		activity := nextAccountActivity(t, activities)
+		if activity.Account.ID == account1.ID || activity.Account.ID == account2.ID {
+			received[activity.Account.ID] = activity
+		}
+	}
+
+	require.Equal(t, result.FromAccount.Balance, received[account1.ID].Account.Balance)
+	require.Equal(t, result.FromEntry.ID, received[account1.ID].Entry.ID)
+	require.Equal(t, result.ToAccount.Balance, received[account2.ID].Account.Balance)
+	require.Equal(t, result.ToEntry.ID, received[account2.ID].Entry.ID)
+}
@@ -0,0 +1,71 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.22.0
+// source: account_adjustment.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createAccountAdjustment = `-- name: CreateAccountAdjustment :one
+INSERT INTO account_adjustments (
+  account_id,
+  entry_id,
+  amount,
+  reason,
+  created_by
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING id, account_id, entry_id, amount, reason, created_by, created_at
+`
+
+type CreateAccountAdjustmentParams struct {
+	AccountID int64  `json:"account_id"`
+	EntryID   int64  `json:"entry_id"`
+	Amount    int64  `json:"amount"`
+	Reason    string `json:"reason"`
+	CreatedBy string `json:"created_by"`
+}
+
+func (q *Queries) CreateAccountAdjustment(ctx context.Context, arg CreateAccountAdjustmentParams) (AccountAdjustment, error) {
+	row := q.db.QueryRow(ctx, createAccountAdjustment,
+		arg.AccountID,
+		arg.EntryID,
+		arg.Amount,
+		arg.Reason,
+		arg.CreatedBy,
+	)
+	var i AccountAdjustment
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.EntryID,
+		&i.Amount,
+		&i.Reason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAccountAdjustment = `-- name: GetAccountAdjustment :one
+SELECT id, account_id, entry_id, amount, reason, created_by, created_at FROM account_adjustments
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetAccountAdjustment(ctx context.Context, id int64) (AccountAdjustment, error) {
+	row := q.db.QueryRow(ctx, getAccountAdjustment, id)
+	var i AccountAdjustment
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.EntryID,
+		&i.Amount,
+		&i.Reason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
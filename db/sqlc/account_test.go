@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,11 +11,15 @@ import (
 )
 
 func createRandomAccount(t *testing.T) Account {
+	return createRandomAccountWithBalance(t, util.RandomMoney())
+}
+
+func createRandomAccountWithBalance(t *testing.T, balance int64) Account {
 	user := createRandomUser(t)
 
 	arg := CreateAccountParams{
 		Owner:    user.Username,
-		Balance:  util.RandomMoney(),
+		Balance:  balance,
 		Currency: util.RandomCurrency(),
 	}
 
@@ -68,35 +73,67 @@ func TestUpdateAccount(t *testing.T) {
 	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
 }
 
-func TestDeleteAccount(t *testing.T) {
-	account1 := createRandomAccount(t)
-	err := testStore.DeleteAccount(context.Background(), account1.ID)
-	require.NoError(t, err)
-
-	account2, err := testStore.GetAccount(context.Background(), account1.ID)
-	require.Error(t, err)
-	require.EqualError(t, err, ErrRecordNotFound.Error())
-	require.Empty(t, account2)
-}
-
 func TestListAccounts(t *testing.T) {
-	var lastAccount Account
+	owner := createRandomUser(t)
 	for i := 0; i < 10; i++ {
-		lastAccount = createRandomAccount(t)
+		_, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    owner.Username,
+			Balance:  util.RandomMoney(),
+			Currency: fmt.Sprintf("C%02d", i),
+		})
+		require.NoError(t, err)
 	}
 
 	arg := ListAccountsParams{
-		Owner:  lastAccount.Owner,
-		Limit:  5,
-		Offset: 0,
+		Owner: owner.Username,
+		Limit: 5,
 	}
 
-	accounts, err := testStore.ListAccounts(context.Background(), arg)
+	firstPage, err := testStore.ListAccounts(context.Background(), arg)
 	require.NoError(t, err)
-	require.NotEmpty(t, accounts)
+	require.Len(t, firstPage, 5)
 
-	for _, account := range accounts {
-		require.NotEmpty(t, account)
-		require.Equal(t, lastAccount.Owner, account.Owner)
+	last := firstPage[len(firstPage)-1]
+	arg.AfterCreatedAt = last.CreatedAt
+	arg.AfterID = last.ID
+
+	secondPage, err := testStore.ListAccounts(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, secondPage, 5)
+
+	// pages follow each other without overlap
+	seen := make(map[int64]bool)
+	for _, account := range append(firstPage, secondPage...) {
+		require.Equal(t, owner.Username, account.Owner)
+		require.NotContains(t, seen, account.ID)
+		seen[account.ID] = true
 	}
+	require.True(t, secondPage[0].CreatedAt.After(last.CreatedAt) ||
+		(secondPage[0].CreatedAt.Equal(last.CreatedAt) && secondPage[0].ID > last.ID))
+}
+
+func TestUpdateAccountStatus(t *testing.T) {
+	account1 := createRandomAccount(t)
+	require.Equal(t, util.AccountStatusActive, account1.Status)
+
+	account2, err := testStore.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     account1.ID,
+		Status: util.AccountStatusFrozen,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, account2.ID)
+	require.Equal(t, util.AccountStatusFrozen, account2.Status)
+
+	// unknown statuses are refused by the database
+	_, err = testStore.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     account1.ID,
+		Status: "unknown",
+	})
+	require.Equal(t, CheckViolation, ErrorCode(err))
+
+	_, err = testStore.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     -1,
+		Status: util.AccountStatusActive,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
 }
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+)
+
+// AuditMetadata tells who is behind a change and where the request came from
+type AuditMetadata struct {
+	// Actor is the username of the authenticated user, or empty when unknown.
+	Actor     string
+	ClientIP  string
+	UserAgent string
+}
+
+// redactedValue replaces secrets in the audit log
+const redactedValue = "[redacted]"
+
+// redactedAuditFields are never written to the audit log, only the fact that they changed
+var redactedAuditFields = map[string]bool{
+	"hashed_password": true,
+	"totp_secret":     true,
+	"recovery_codes":  true,
+	"refresh_token":   true,
+	"secret_code":     true,
+}
+
+// recordAuditEvent records a change in the audit log, within the transaction of q.
+// Only the fields that differ between before and after are kept, either of which may be nil.
+func recordAuditEvent(
+	ctx context.Context,
+	q *Queries,
+	meta AuditMetadata,
+	action string,
+	targetType string,
+	targetID string,
+	before any,
+	after any,
+) error {
+	beforeFields, afterFields, err := auditDiff(before, after)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateAuditEvent(ctx, CreateAuditEventParams{
+		Actor:      meta.Actor,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     beforeFields,
+		After:      afterFields,
+		ClientIp:   meta.ClientIP,
+		UserAgent:  meta.UserAgent,
+	})
+	return err
+}
+
+// auditDiff returns the JSON of the fields that changed between before and after, with secrets redacted
+func auditDiff(before any, after any) ([]byte, []byte, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for key, value := range beforeFields {
+		if afterValue, ok := afterFields[key]; ok && reflect.DeepEqual(value, afterValue) {
+			delete(beforeFields, key)
+			delete(afterFields, key)
+		}
+	}
+
+	beforeJSON, err := marshalAuditFields(beforeFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	afterJSON, err := marshalAuditFields(afterFields)
+	return beforeJSON, afterJSON, err
+}
+
+func auditFields(value any) (map[string]any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	err = json.Unmarshal(data, &fields)
+	return fields, err
+}
+
+// marshalAuditFields redacts the secrets, which is only done after diffing so that changing one is still recorded
+func marshalAuditFields(fields map[string]any) ([]byte, error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	for key := range fields {
+		if redactedAuditFields[key] {
+			fields[key] = redactedValue
+		}
+	}
+
+	return json.Marshal(fields)
+}
@@ -0,0 +1,133 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.22.0
+// source: audit_event.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditEvent = `-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+  actor,
+  action,
+  target_type,
+  target_id,
+  before,
+  after,
+  client_ip,
+  user_agent
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, actor, action, target_type, target_id, before, after, client_ip, user_agent, created_at
+`
+
+type CreateAuditEventParams struct {
+	Actor      string `json:"actor"`
+	Action     string `json:"action"`
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Before     []byte `json:"before"`
+	After      []byte `json:"after"`
+	ClientIp   string `json:"client_ip"`
+	UserAgent  string `json:"user_agent"`
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error) {
+	row := q.db.QueryRow(ctx, createAuditEvent,
+		arg.Actor,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Before,
+		arg.After,
+		arg.ClientIp,
+		arg.UserAgent,
+	)
+	var i AuditEvent
+	err := row.Scan(
+		&i.ID,
+		&i.Actor,
+		&i.Action,
+		&i.TargetType,
+		&i.TargetID,
+		&i.Before,
+		&i.After,
+		&i.ClientIp,
+		&i.UserAgent,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT id, actor, action, target_type, target_id, before, after, client_ip, user_agent, created_at FROM audit_events
+WHERE
+    ($1::varchar IS NULL OR actor = $1) AND
+    ($2::varchar IS NULL OR action = $2) AND
+    ($3::varchar IS NULL OR target_type = $3) AND
+    ($4::varchar IS NULL OR target_id = $4) AND
+    created_at >= $5 AND
+    created_at < $6 AND
+    (created_at, id) > ($7::timestamptz, $8::bigint)
+ORDER BY created_at, id
+LIMIT $9
+`
+
+type ListAuditEventsParams struct {
+	Actor          pgtype.Text `json:"actor"`
+	Action         pgtype.Text `json:"action"`
+	TargetType     pgtype.Text `json:"target_type"`
+	TargetID       pgtype.Text `json:"target_id"`
+	StartTime      time.Time   `json:"start_time"`
+	EndTime        time.Time   `json:"end_time"`
+	AfterCreatedAt time.Time   `json:"after_created_at"`
+	AfterID        int64       `json:"after_id"`
+	Limit          int32       `json:"limit"`
+}
+
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEvents,
+		arg.Actor,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditEvent{}
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Actor,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Before,
+			&i.After,
+			&i.ClientIp,
+			&i.UserAgent,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,269 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAuditEvent(t *testing.T, actor string) AuditEvent {
+	arg := CreateAuditEventParams{
+		Actor:      actor,
+		Action:     util.AuditActionUserUpdate,
+		TargetType: util.AuditTargetUser,
+		TargetID:   actor,
+		Before:     []byte(`{"full_name": "before"}`),
+		After:      []byte(`{"full_name": "after"}`),
+		ClientIp:   "192.0.2.1",
+		UserAgent:  "grpc-go",
+	}
+
+	event, err := testStore.CreateAuditEvent(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, event.ID)
+	require.Equal(t, arg.Actor, event.Actor)
+	require.Equal(t, arg.Action, event.Action)
+	require.JSONEq(t, string(arg.Before), string(event.Before))
+	require.JSONEq(t, string(arg.After), string(event.After))
+	require.NotZero(t, event.CreatedAt)
+
+	return event
+}
+
+func TestCreateAuditEvent(t *testing.T) {
+	user := createRandomUser(t)
+	createRandomAuditEvent(t, user.Username)
+}
+
+func TestAuditEventsAppendOnly(t *testing.T) {
+	user := createRandomUser(t)
+	event := createRandomAuditEvent(t, user.Username)
+
+	connPool := testStore.(*SQLStore).connPool
+
+	_, err := connPool.Exec(context.Background(), "UPDATE audit_events SET actor = '' WHERE id = $1", event.ID)
+	require.ErrorContains(t, err, "audit events cannot be changed or deleted")
+
+	_, err = connPool.Exec(context.Background(), "DELETE FROM audit_events WHERE id = $1", event.ID)
+	require.ErrorContains(t, err, "audit events cannot be changed or deleted")
+}
+
+func TestListAuditEvents(t *testing.T) {
+	user := createRandomUser(t)
+	for i := 0; i < 5; i++ {
+		createRandomAuditEvent(t, user.Username)
+	}
+
+	arg := ListAuditEventsParams{
+		Actor:   pgtype.Text{String: user.Username, Valid: true},
+		Action:  pgtype.Text{String: util.AuditActionUserUpdate, Valid: true},
+		EndTime: time.Now().Add(time.Minute),
+		Limit:   3,
+	}
+
+	events, err := testStore.ListAuditEvents(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for _, event := range events {
+		require.Equal(t, user.Username, event.Actor)
+	}
+
+	last := events[len(events)-1]
+	arg.AfterCreatedAt = last.CreatedAt
+	arg.AfterID = last.ID
+
+	events, err = testStore.ListAuditEvents(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Greater(t, events[0].ID, last.ID)
+}
+
+func TestAdjustAccountBalanceTxRecordsAuditEvent(t *testing.T) {
+	account := createRandomAccountWithBalance(t, 100)
+	banker := createRandomUser(t)
+
+	audit := AuditMetadata{
+		Actor:     banker.Username,
+		ClientIP:  "192.0.2.1",
+		UserAgent: "grpc-go",
+	}
+	_, err := testStore.AdjustAccountBalanceTx(context.Background(), AdjustAccountBalanceTxParams{
+		AccountID: account.ID,
+		Amount:    25,
+		Reason:    "refund card fee",
+		Audit:     audit,
+	})
+	require.NoError(t, err)
+
+	events, err := testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		TargetType: pgtype.Text{String: util.AuditTargetAccount, Valid: true},
+		TargetID:   pgtype.Text{String: strconv.FormatInt(account.ID, 10), Valid: true},
+		EndTime:    time.Now().Add(time.Minute),
+		Limit:      10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	event := events[0]
+	require.Equal(t, audit.Actor, event.Actor)
+	require.Equal(t, util.AuditActionAccountAdjust, event.Action)
+	require.Equal(t, audit.ClientIP, event.ClientIp)
+	require.Equal(t, audit.UserAgent, event.UserAgent)
+	require.JSONEq(t, `{"balance": 100}`, string(event.Before))
+	require.JSONEq(t, `{"balance": 125}`, string(event.After))
+}
+
+func listTargetAuditEvents(t *testing.T, targetType string, targetID string) []AuditEvent {
+	events, err := testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		TargetType: pgtype.Text{String: targetType, Valid: true},
+		TargetID:   pgtype.Text{String: targetID, Valid: true},
+		EndTime:    time.Now().Add(time.Minute),
+		Limit:      10,
+	})
+	require.NoError(t, err)
+	return events
+}
+
+func TestUserTotpTxRecordsAuditEvents(t *testing.T) {
+	user := createRandomUser(t)
+	audit := AuditMetadata{Actor: user.Username}
+
+	enabled, err := testStore.EnableUserTotpTx(context.Background(), EnableUserTotpTxParams{
+		EnableUserTotpParams: EnableUserTotpParams{
+			Username:      user.Username,
+			TotpLastStep:  1,
+			RecoveryCodes: []string{util.RandomString(10)},
+		},
+		Audit: audit,
+	})
+	require.NoError(t, err)
+	require.True(t, enabled.User.IsTotpEnabled)
+
+	disabled, err := testStore.DisableUserTotpTx(context.Background(), DisableUserTotpTxParams{
+		Username: user.Username,
+		Audit:    audit,
+	})
+	require.NoError(t, err)
+	require.False(t, disabled.User.IsTotpEnabled)
+
+	events := listTargetAuditEvents(t, util.AuditTargetUser, user.Username)
+	require.Len(t, events, 2)
+	require.Equal(t, util.AuditActionUserEnableTwoFactor, events[0].Action)
+	require.Equal(t, util.AuditActionUserDisableTwoFactor, events[1].Action)
+
+	// the recovery codes are only known to have changed
+	var after map[string]any
+	require.NoError(t, json.Unmarshal(events[0].After, &after))
+	require.Equal(t, true, after["is_totp_enabled"])
+	require.Equal(t, redactedValue, after["recovery_codes"])
+}
+
+func TestRecordLoginFailureTx(t *testing.T) {
+	// the username of a failed login may not exist
+	username := util.RandomOwner()
+	audit := AuditMetadata{ClientIP: "192.0.2.1"}
+
+	err := testStore.RecordLoginFailureTx(context.Background(), RecordLoginFailureTxParams{
+		Username: username,
+		Audit:    audit,
+	})
+	require.NoError(t, err)
+
+	err = testStore.RecordLoginFailureTx(context.Background(), RecordLoginFailureTxParams{
+		Username: username,
+		Locked:   true,
+		Audit:    audit,
+	})
+	require.NoError(t, err)
+
+	events := listTargetAuditEvents(t, util.AuditTargetUser, username)
+	require.Len(t, events, 3)
+	require.Equal(t, util.AuditActionUserLoginFailed, events[0].Action)
+	require.Equal(t, util.AuditActionUserLoginFailed, events[1].Action)
+	require.Equal(t, util.AuditActionUserLockout, events[2].Action)
+	for _, event := range events {
+		require.Empty(t, event.Actor)
+		require.Equal(t, audit.ClientIP, event.ClientIp)
+	}
+}
+
+func TestBlockSessionTxRecordsAuditEvents(t *testing.T) {
+	user := createRandomUser(t)
+	session1 := createRandomSession(t, user)
+	session2 := createRandomSession(t, user)
+	audit := AuditMetadata{Actor: user.Username}
+
+	result, err := testStore.LogoutSessionTx(context.Background(), BlockSessionTxParams{
+		SessionID: session1.ID,
+		Audit:     audit,
+	})
+	require.NoError(t, err)
+	require.True(t, result.Session.IsBlocked)
+
+	result, err = testStore.RevokeSessionTx(context.Background(), BlockSessionTxParams{
+		SessionID: session2.ID,
+		Audit:     audit,
+	})
+	require.NoError(t, err)
+	require.True(t, result.Session.IsBlocked)
+
+	events := listTargetAuditEvents(t, util.AuditTargetSession, session1.ID.String())
+	require.Len(t, events, 1)
+	require.Equal(t, util.AuditActionSessionLogout, events[0].Action)
+	require.JSONEq(t, `{"is_blocked": true}`, string(events[0].After))
+
+	events = listTargetAuditEvents(t, util.AuditTargetSession, session2.ID.String())
+	require.Len(t, events, 1)
+	require.Equal(t, util.AuditActionSessionRevoke, events[0].Action)
+}
+
+func TestRevokeAllSessionsTxRecordsAuditEvent(t *testing.T) {
+	user := createRandomUser(t)
+	createRandomSession(t, user)
+	createRandomSession(t, user)
+
+	result, err := testStore.RevokeAllSessionsTx(context.Background(), RevokeAllSessionsTxParams{
+		Username: user.Username,
+		Audit:    AuditMetadata{Actor: user.Username},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), result.RevokedSessions)
+
+	events := listTargetAuditEvents(t, util.AuditTargetUser, user.Username)
+	require.Len(t, events, 1)
+	require.Equal(t, util.AuditActionUserRevokeAllSessions, events[0].Action)
+	require.JSONEq(t, `{"revoked_sessions": 2}`, string(events[0].After))
+}
+
+func TestAuditDiff(t *testing.T) {
+	before := User{
+		Username:       util.RandomOwner(),
+		FullName:       util.RandomOwner(),
+		Email:          util.RandomEmail(),
+		HashedPassword: "old hash",
+	}
+	after := before
+	after.FullName = util.RandomOwner()
+	after.HashedPassword = "new hash"
+
+	beforeJSON, afterJSON, err := auditDiff(before, after)
+	require.NoError(t, err)
+
+	var beforeFields, afterFields map[string]any
+	require.NoError(t, json.Unmarshal(beforeJSON, &beforeFields))
+	require.NoError(t, json.Unmarshal(afterJSON, &afterFields))
+
+	require.Equal(t, map[string]any{"full_name": before.FullName, "hashed_password": redactedValue}, beforeFields)
+	require.Equal(t, map[string]any{"full_name": after.FullName, "hashed_password": redactedValue}, afterFields)
+
+	beforeJSON, afterJSON, err = auditDiff(nil, after)
+	require.NoError(t, err)
+	require.Nil(t, beforeJSON)
+	require.NotNil(t, afterJSON)
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventTaskOptions tells which worker task posts a domain event to one webhook subscription, and how it runs.
+// The worker owns the task, so it is given to NewStore by the caller.
+type EventTaskOptions struct {
+	TaskType string
+	Queue    string
+	MaxRetry int32
+}
+
+// DomainEvent is something that happened in the bank that partners can subscribe to with a webhook
+type DomainEvent struct {
+	ID         uuid.UUID       `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// PayloadDeliverWebhook is the payload of the task that posts a domain event to a webhook
+type PayloadDeliverWebhook struct {
+	SubscriptionID int64       `json:"subscription_id"`
+	Event          DomainEvent `json:"event"`
+}
+
+// EmailVerifiedEventData is the data of a user.email_verified event
+type EmailVerifiedEventData struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// recordDomainEvent writes the event task of the store to the outbox for every subscription of the owners to the event,
+// within the transaction of q
+func (store *SQLStore) recordDomainEvent(ctx context.Context, q *Queries, eventType string, owners []string, data any) error {
+	subscriptions, err := q.ListWebhookSubscriptionsForEvent(ctx, ListWebhookSubscriptionsForEventParams{
+		Owners:    owners,
+		EventType: eventType,
+	})
+	if err != nil || len(subscriptions) == 0 {
+		return err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	event := DomainEvent{
+		ID:         uuid.New(),
+		Type:       eventType,
+		OccurredAt: time.Now(),
+		Data:       jsonData,
+	}
+
+	tasks := make([]CreateOutboxTaskParams, len(subscriptions))
+	for i, subscription := range subscriptions {
+		payload, err := json.Marshal(PayloadDeliverWebhook{
+			SubscriptionID: subscription.ID,
+			Event:          event,
+		})
+		if err != nil {
+			return err
+		}
+
+		tasks[i] = CreateOutboxTaskParams{
+			TaskType:  store.eventTask.TaskType,
+			Payload:   payload,
+			Queue:     store.eventTask.Queue,
+			MaxRetry:  store.eventTask.MaxRetry,
+			ProcessAt: event.OccurredAt,
+		}
+	}
+
+	return createOutboxTasks(ctx, q, tasks)
+}
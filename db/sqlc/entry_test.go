@@ -12,7 +12,8 @@ import (
 func createRandomEntry(t *testing.T, account Account) Entry {
 	arg := CreateEntryParams{
 		AccountID: account.ID,
-		Amount:    util.RandomMoney(),
+		Amount:    util.RandomInt(1, 1000),
+		Balance:   util.RandomMoney(),
 	}
 
 	entry, err := testStore.CreateEntry(context.Background(), arg)
@@ -21,6 +22,7 @@ func createRandomEntry(t *testing.T, account Account) Entry {
 
 	require.Equal(t, arg.AccountID, entry.AccountID)
 	require.Equal(t, arg.Amount, entry.Amount)
+	require.Equal(t, arg.Balance, entry.Balance)
 
 	require.NotZero(t, entry.ID)
 	require.NotZero(t, entry.CreatedAt)
@@ -43,6 +45,7 @@ func TestGetEntry(t *testing.T) {
 	require.Equal(t, entry1.ID, entry2.ID)
 	require.Equal(t, entry1.AccountID, entry2.AccountID)
 	require.Equal(t, entry1.Amount, entry2.Amount)
+	require.Equal(t, entry1.Balance, entry2.Balance)
 	require.WithinDuration(t, entry1.CreatedAt, entry2.CreatedAt, time.Second)
 }
 
@@ -54,16 +57,72 @@ func TestListEntries(t *testing.T) {
 
 	arg := ListEntriesParams{
 		AccountID: account.ID,
+		StartTime: time.Now().Add(-time.Minute),
+		EndTime:   time.Now().Add(time.Minute),
+		Incoming:  true,
+		Outgoing:  true,
 		Limit:     5,
-		Offset:    5,
 	}
 
 	entries, err := testStore.ListEntries(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, entries, 5)
 
-	for _, entry := range entries {
+	last := entries[len(entries)-1]
+	arg.AfterCreatedAt = last.CreatedAt
+	arg.AfterID = last.ID
+
+	nextEntries, err := testStore.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, nextEntries, 5)
+
+	for _, entry := range append(entries, nextEntries...) {
 		require.NotEmpty(t, entry)
 		require.Equal(t, arg.AccountID, entry.AccountID)
 	}
+	require.Greater(t, nextEntries[0].ID, last.ID)
+}
+
+func TestListEntriesFilters(t *testing.T) {
+	account := createRandomAccount(t)
+	for _, amount := range []int64{10, -5, 20, -15} {
+		_, err := testStore.CreateEntry(context.Background(), CreateEntryParams{
+			AccountID: account.ID,
+			Amount:    amount,
+			Balance:   account.Balance,
+		})
+		require.NoError(t, err)
+	}
+
+	arg := ListEntriesParams{
+		AccountID: account.ID,
+		StartTime: time.Now().Add(-time.Minute),
+		EndTime:   time.Now().Add(time.Minute),
+		Incoming:  true,
+		Limit:     10,
+	}
+
+	entries, err := testStore.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		require.Positive(t, entry.Amount)
+	}
+
+	arg.Incoming = false
+	arg.Outgoing = true
+	entries, err = testStore.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		require.Negative(t, entry.Amount)
+	}
+
+	// nothing before the range
+	arg.Incoming = true
+	arg.EndTime = time.Now().Add(-time.Minute)
+	arg.StartTime = arg.EndTime.Add(-time.Hour)
+	entries, err = testStore.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, entries)
 }
@@ -10,10 +10,38 @@ import (
 const (
 	ForeignKeyViolation = "23503"
 	UniqueViolation     = "23505"
+	CheckViolation      = "23514"
 )
 
 var ErrRecordNotFound = pgx.ErrNoRows
 
+// ErrInsufficientFunds is returned when a transfer or an adjustment would take an account below its overdraft limit
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrAccountNotActive is returned when money would move in or out of a frozen or closed account
+var ErrAccountNotActive = errors.New("account is not active")
+
+// ErrAccountStatusTransition is returned when an account cannot move from its current status to the requested one
+var ErrAccountStatusTransition = errors.New("account status transition is not allowed")
+
+// ErrAccountBalanceNotZero is returned when closing an account that still holds or owes money and nothing sweeps it
+var ErrAccountBalanceNotZero = errors.New("account balance is not zero")
+
+// ErrIdempotencyKeyReused is returned when an idempotency key is replayed with different transfer parameters
+var ErrIdempotencyKeyReused = errors.New("idempotency key already used for a different transfer")
+
+// ErrInvalidConvertedAmount is returned when a cross-currency transfer would credit a non-positive amount
+var ErrInvalidConvertedAmount = errors.New("converted amount must be positive")
+
+// ErrSessionBlocked is returned when renewing a session that was blocked
+var ErrSessionBlocked = errors.New("session is blocked")
+
+// ErrRefreshTokenReused is returned when a refresh token that was already rotated is used again
+var ErrRefreshTokenReused = errors.New("refresh token was already used")
+
+// ErrPendingEmailSuperseded is returned when verifying a pending email that was changed again after the code was sent
+var ErrPendingEmailSuperseded = errors.New("pending email was changed again")
+
 var ErrUniqueViolation = &pgconn.PgError{
 	Code: UniqueViolation,
 }
@@ -0,0 +1,96 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.22.0
+// source: exchange_rate.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getExchangeRate = `-- name: GetExchangeRate :one
+SELECT from_currency, to_currency, rate, updated_at FROM exchange_rates
+WHERE from_currency = $1 AND to_currency = $2
+LIMIT 1
+`
+
+type GetExchangeRateParams struct {
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+}
+
+func (q *Queries) GetExchangeRate(ctx context.Context, arg GetExchangeRateParams) (ExchangeRate, error) {
+	row := q.db.QueryRow(ctx, getExchangeRate, arg.FromCurrency, arg.ToCurrency)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listExchangeRates = `-- name: ListExchangeRates :many
+SELECT from_currency, to_currency, rate, updated_at FROM exchange_rates
+ORDER BY from_currency, to_currency
+`
+
+func (q *Queries) ListExchangeRates(ctx context.Context) ([]ExchangeRate, error) {
+	rows, err := q.db.Query(ctx, listExchangeRates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExchangeRate{}
+	for rows.Next() {
+		var i ExchangeRate
+		if err := rows.Scan(
+			&i.FromCurrency,
+			&i.ToCurrency,
+			&i.Rate,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertExchangeRate = `-- name: UpsertExchangeRate :one
+INSERT INTO exchange_rates (
+  from_currency,
+  to_currency,
+  rate
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (from_currency, to_currency) DO UPDATE
+SET rate = EXCLUDED.rate, updated_at = now()
+RETURNING from_currency, to_currency, rate, updated_at
+`
+
+type UpsertExchangeRateParams struct {
+	FromCurrency string         `json:"from_currency"`
+	ToCurrency   string         `json:"to_currency"`
+	Rate         pgtype.Numeric `json:"rate"`
+}
+
+func (q *Queries) UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) (ExchangeRate, error) {
+	row := q.db.QueryRow(ctx, upsertExchangeRate, arg.FromCurrency, arg.ToCurrency, arg.Rate)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
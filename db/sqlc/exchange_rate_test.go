@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseExchangeRate(t *testing.T, value string) pgtype.Numeric {
+	rate, err := ParseExchangeRate(value)
+	require.NoError(t, err)
+	return rate
+}
+
+func TestUpsertExchangeRate(t *testing.T) {
+	arg := UpsertExchangeRateParams{
+		FromCurrency: util.RandomString(3),
+		ToCurrency:   util.RandomString(3),
+		Rate:         mustParseExchangeRate(t, "1.25"),
+	}
+
+	rate1, err := testStore.UpsertExchangeRate(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.FromCurrency, rate1.FromCurrency)
+	require.Equal(t, arg.ToCurrency, rate1.ToCurrency)
+	require.Equal(t, FormatExchangeRate(arg.Rate), FormatExchangeRate(rate1.Rate))
+	require.NotZero(t, rate1.UpdatedAt)
+
+	arg.Rate = mustParseExchangeRate(t, "1.5")
+	rate2, err := testStore.UpsertExchangeRate(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, FormatExchangeRate(arg.Rate), FormatExchangeRate(rate2.Rate))
+
+	rate3, err := testStore.GetExchangeRate(context.Background(), GetExchangeRateParams{
+		FromCurrency: arg.FromCurrency,
+		ToCurrency:   arg.ToCurrency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, rate2, rate3)
+}
+
+func TestUpsertExchangeRateNotPositive(t *testing.T) {
+	_, err := testStore.UpsertExchangeRate(context.Background(), UpsertExchangeRateParams{
+		FromCurrency: util.RandomString(3),
+		ToCurrency:   util.RandomString(3),
+		Rate:         pgtype.Numeric{Int: big.NewInt(0), Valid: true},
+	})
+	require.Equal(t, CheckViolation, ErrorCode(err))
+}
@@ -0,0 +1,80 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.22.0
+// source: idempotency_key.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createIdempotencyKey = `-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys (
+  from_account_id,
+  idempotency_key,
+  to_account_id,
+  amount,
+  transfer_id,
+  result
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING from_account_id, idempotency_key, to_account_id, amount, transfer_id, result, created_at
+`
+
+type CreateIdempotencyKeyParams struct {
+	FromAccountID  int64  `json:"from_account_id"`
+	IdempotencyKey string `json:"idempotency_key"`
+	ToAccountID    int64  `json:"to_account_id"`
+	Amount         int64  `json:"amount"`
+	TransferID     int64  `json:"transfer_id"`
+	Result         []byte `json:"result"`
+}
+
+func (q *Queries) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, createIdempotencyKey,
+		arg.FromAccountID,
+		arg.IdempotencyKey,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.TransferID,
+		arg.Result,
+	)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.FromAccountID,
+		&i.IdempotencyKey,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.TransferID,
+		&i.Result,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT from_account_id, idempotency_key, to_account_id, amount, transfer_id, result, created_at FROM idempotency_keys
+WHERE from_account_id = $1 AND idempotency_key = $2
+LIMIT 1
+`
+
+type GetIdempotencyKeyParams struct {
+	FromAccountID  int64  `json:"from_account_id"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.FromAccountID, arg.IdempotencyKey)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.FromAccountID,
+		&i.IdempotencyKey,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.TransferID,
+		&i.Result,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -12,6 +12,13 @@ import (
 
 var testStore Store
 
+// testEventTask stands for the webhook task of the worker, which cannot be imported here
+var testEventTask = EventTaskOptions{
+	TaskType: "task:deliver_webhook",
+	Queue:    "default",
+	MaxRetry: 10,
+}
+
 func TestMain(m *testing.M) {
 	config, err := util.LoadConfig("../..")
 	if err != nil {
@@ -23,6 +30,6 @@ func TestMain(m *testing.M) {
 		log.Fatal("cannot connect to db:", err)
 	}
 
-	testStore = NewStore(connPool)
+	testStore = NewStore(connPool, testEventTask)
 	os.Exit(m.Run())
 }
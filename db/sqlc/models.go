@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Account struct {
@@ -16,6 +17,49 @@ type Account struct {
 	Balance   int64     `json:"balance"`
 	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
+	// balance must never drop below -overdraft_limit
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	// money only moves in or out of an active account
+	Status string `json:"status"`
+}
+
+type AccountAdjustment struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	EntryID   int64 `json:"entry_id"`
+	// can be negative or positive
+	Amount int64  `json:"amount"`
+	Reason string `json:"reason"`
+	// banker who posted the adjustment
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AccountStatusChange struct {
+	ID         int64  `json:"id"`
+	AccountID  int64  `json:"account_id"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Reason     string `json:"reason"`
+	// owner or banker who changed the status
+	ChangedBy string    `json:"changed_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AuditEvent struct {
+	ID int64 `json:"id"`
+	// username of who made the change, empty when unknown
+	Actor      string `json:"actor"`
+	Action     string `json:"action"`
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	// changed fields before the change, secrets are redacted
+	Before []byte `json:"before"`
+	// changed fields after the change, secrets are redacted
+	After     []byte    `json:"after"`
+	ClientIp  string    `json:"client_ip"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Entry struct {
@@ -24,6 +68,66 @@ type Entry struct {
 	// can be negative or positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// account balance right after this entry
+	Balance int64 `json:"balance"`
+}
+
+type ExchangeRate struct {
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+	// units of to_currency for one unit of from_currency
+	Rate      pgtype.Numeric `json:"rate"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type IdempotencyKey struct {
+	FromAccountID  int64  `json:"from_account_id"`
+	IdempotencyKey string `json:"idempotency_key"`
+	ToAccountID    int64  `json:"to_account_id"`
+	Amount         int64  `json:"amount"`
+	TransferID     int64  `json:"transfer_id"`
+	// the TransferTxResult returned to the first request
+	Result    []byte    `json:"result"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type OutboxTask struct {
+	ID       int64  `json:"id"`
+	TaskType string `json:"task_type"`
+	Payload  []byte `json:"payload"`
+	Queue    string `json:"queue"`
+	MaxRetry int32  `json:"max_retry"`
+	// the worker must not process the task before this time
+	ProcessAt time.Time `json:"process_at"`
+	// failed attempts to publish the task
+	Attempts  int32     `json:"attempts"`
+	LastError string    `json:"last_error"`
+	CreatedAt time.Time `json:"created_at"`
+	// when the relay published the task, null while pending
+	SentAt pgtype.Timestamptz `json:"sent_at"`
+	// the relay must not publish the task before this time, pushed back while it is claimed and after each failure
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+}
+
+type PasswordReset struct {
+	ID         int64     `json:"id"`
+	Username   string    `json:"username"`
+	SecretCode string    `json:"secret_code"`
+	IsUsed     bool      `json:"is_used"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiredAt  time.Time `json:"expired_at"`
+}
+
+type Role struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type RolePermission struct {
+	Role string `json:"role"`
+	// resource:action:scope, an any scope also grants the own scope
+	Permission string `json:"permission"`
 }
 
 type Session struct {
@@ -35,6 +139,11 @@ type Session struct {
 	IsBlocked    bool      `json:"is_blocked"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	CreatedAt    time.Time `json:"created_at"`
+	// id of the login session this one was rotated from
+	FamilyID uuid.UUID   `json:"family_id"`
+	ParentID pgtype.UUID `json:"parent_id"`
+	// its refresh token was exchanged for a new session
+	IsRotated bool `json:"is_rotated"`
 }
 
 type Transfer struct {
@@ -44,6 +153,10 @@ type Transfer struct {
 	// must be positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// amount credited to the receiver, in its currency
+	ToAmount int64 `json:"to_amount"`
+	// rate applied to amount to get to_amount
+	ExchangeRate pgtype.Numeric `json:"exchange_rate"`
 }
 
 type User struct {
@@ -55,6 +168,15 @@ type User struct {
 	CreatedAt         time.Time `json:"created_at"`
 	IsEmailVerified   bool      `json:"is_email_verified"`
 	Role              string    `json:"role"`
+	// encrypted with TOTP_ENCRYPTION_KEY
+	TotpSecret    pgtype.Text `json:"totp_secret"`
+	IsTotpEnabled bool        `json:"is_totp_enabled"`
+	// time step of the last accepted code, to refuse replays
+	TotpLastStep int64 `json:"totp_last_step"`
+	// sha256 hashes of the unused recovery codes
+	RecoveryCodes []string `json:"recovery_codes"`
+	// new email address waiting to be verified before it replaces email
+	PendingEmail pgtype.Text `json:"pending_email"`
 }
 
 type VerifyEmail struct {
@@ -66,3 +188,27 @@ type VerifyEmail struct {
 	CreatedAt  time.Time `json:"created_at"`
 	ExpiredAt  time.Time `json:"expired_at"`
 }
+
+type WebhookDelivery struct {
+	ID             int64     `json:"id"`
+	SubscriptionID int64     `json:"subscription_id"`
+	EventID        uuid.UUID `json:"event_id"`
+	EventType      string    `json:"event_type"`
+	Attempt        int32     `json:"attempt"`
+	// HTTP status of the response, 0 when there was none
+	StatusCode int32 `json:"status_code"`
+	// empty when the webhook answered with a 2xx status
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WebhookSubscription struct {
+	ID int64 `json:"id"`
+	// events about this user and their accounts are delivered
+	Owner      string   `json:"owner"`
+	Url        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	// key of the HMAC-SHA256 signature, encrypted with WEBHOOK_ENCRYPTION_KEY
+	EncryptedSecret string    `json:"encrypted_secret"`
+	CreatedAt       time.Time `json:"created_at"`
+}
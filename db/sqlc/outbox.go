@@ -0,0 +1,15 @@
+package db
+
+import "context"
+
+// createOutboxTasks writes worker tasks to the outbox within the transaction of q,
+// so that they are only published if the change that needs them is committed
+func createOutboxTasks(ctx context.Context, q *Queries, tasks []CreateOutboxTaskParams) error {
+	for _, task := range tasks {
+		_, err := q.CreateOutboxTask(ctx, task)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.22.0
+// source: outbox_task.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const claimOutboxTasks = `-- name: ClaimOutboxTasks :many
+UPDATE outbox_tasks
+SET next_attempt_at = $1
+WHERE id IN (
+  SELECT pending.id FROM outbox_tasks AS pending
+  WHERE pending.sent_at IS NULL
+    AND pending.next_attempt_at <= now()
+    AND pending.attempts < $2
+  ORDER BY pending.id
+  LIMIT $3
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, task_type, payload, queue, max_retry, process_at, attempts, last_error, created_at, sent_at, next_attempt_at
+`
+
+type ClaimOutboxTasksParams struct {
+	ClaimedUntil time.Time `json:"claimed_until"`
+	MaxAttempts  int32     `json:"max_attempts"`
+	TaskLimit    int32     `json:"task_limit"`
+}
+
+func (q *Queries) ClaimOutboxTasks(ctx context.Context, arg ClaimOutboxTasksParams) ([]OutboxTask, error) {
+	rows, err := q.db.Query(ctx, claimOutboxTasks, arg.ClaimedUntil, arg.MaxAttempts, arg.TaskLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxTask{}
+	for rows.Next() {
+		var i OutboxTask
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskType,
+			&i.Payload,
+			&i.Queue,
+			&i.MaxRetry,
+			&i.ProcessAt,
+			&i.Attempts,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.SentAt,
+			&i.NextAttemptAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createOutboxTask = `-- name: CreateOutboxTask :one
+INSERT INTO outbox_tasks (
+  task_type,
+  payload,
+  queue,
+  max_retry,
+  process_at
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING id, task_type, payload, queue, max_retry, process_at, attempts, last_error, created_at, sent_at, next_attempt_at
+`
+
+type CreateOutboxTaskParams struct {
+	TaskType  string    `json:"task_type"`
+	Payload   []byte    `json:"payload"`
+	Queue     string    `json:"queue"`
+	MaxRetry  int32     `json:"max_retry"`
+	ProcessAt time.Time `json:"process_at"`
+}
+
+func (q *Queries) CreateOutboxTask(ctx context.Context, arg CreateOutboxTaskParams) (OutboxTask, error) {
+	row := q.db.QueryRow(ctx, createOutboxTask,
+		arg.TaskType,
+		arg.Payload,
+		arg.Queue,
+		arg.MaxRetry,
+		arg.ProcessAt,
+	)
+	var i OutboxTask
+	err := row.Scan(
+		&i.ID,
+		&i.TaskType,
+		&i.Payload,
+		&i.Queue,
+		&i.MaxRetry,
+		&i.ProcessAt,
+		&i.Attempts,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.SentAt,
+		&i.NextAttemptAt,
+	)
+	return i, err
+}
+
+const getOutboxTask = `-- name: GetOutboxTask :one
+SELECT id, task_type, payload, queue, max_retry, process_at, attempts, last_error, created_at, sent_at, next_attempt_at FROM outbox_tasks
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetOutboxTask(ctx context.Context, id int64) (OutboxTask, error) {
+	row := q.db.QueryRow(ctx, getOutboxTask, id)
+	var i OutboxTask
+	err := row.Scan(
+		&i.ID,
+		&i.TaskType,
+		&i.Payload,
+		&i.Queue,
+		&i.MaxRetry,
+		&i.ProcessAt,
+		&i.Attempts,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.SentAt,
+		&i.NextAttemptAt,
+	)
+	return i, err
+}
+
+const markOutboxTaskSent = `-- name: MarkOutboxTaskSent :exec
+UPDATE outbox_tasks
+SET sent_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) MarkOutboxTaskSent(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markOutboxTaskSent, id)
+	return err
+}
+
+const recordOutboxTaskFailure = `-- name: RecordOutboxTaskFailure :exec
+UPDATE outbox_tasks
+SET
+  attempts = attempts + 1,
+  last_error = $2,
+  next_attempt_at = $3
+WHERE id = $1
+`
+
+type RecordOutboxTaskFailureParams struct {
+	ID            int64     `json:"id"`
+	LastError     string    `json:"last_error"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+}
+
+func (q *Queries) RecordOutboxTaskFailure(ctx context.Context, arg RecordOutboxTaskFailureParams) error {
+	_, err := q.db.Exec(ctx, recordOutboxTaskFailure, arg.ID, arg.LastError, arg.NextAttemptAt)
+	return err
+}
@@ -0,0 +1,107 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.22.0
+// source: password_reset.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createPasswordReset = `-- name: CreatePasswordReset :one
+INSERT INTO password_resets (
+    username,
+    secret_code
+) VALUES (
+    $1, $2
+) RETURNING id, username, secret_code, is_used, created_at, expired_at
+`
+
+type CreatePasswordResetParams struct {
+	Username   string `json:"username"`
+	SecretCode string `json:"secret_code"`
+}
+
+func (q *Queries) CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error) {
+	row := q.db.QueryRow(ctx, createPasswordReset, arg.Username, arg.SecretCode)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const getLatestPasswordReset = `-- name: GetLatestPasswordReset :one
+SELECT id, username, secret_code, is_used, created_at, expired_at FROM password_resets
+WHERE username = $1
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestPasswordReset(ctx context.Context, username string) (PasswordReset, error) {
+	row := q.db.QueryRow(ctx, getLatestPasswordReset, username)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const invalidateUserPasswordResets = `-- name: InvalidateUserPasswordResets :execrows
+UPDATE password_resets
+SET
+    is_used = TRUE
+WHERE
+    username = $1
+    AND is_used = FALSE
+`
+
+func (q *Queries) InvalidateUserPasswordResets(ctx context.Context, username string) (int64, error) {
+	result, err := q.db.Exec(ctx, invalidateUserPasswordResets, username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updatePasswordReset = `-- name: UpdatePasswordReset :one
+UPDATE password_resets
+SET
+    is_used = TRUE
+WHERE
+    id = $1
+    AND secret_code = $2
+    AND is_used = FALSE
+    AND expired_at > now()
+RETURNING id, username, secret_code, is_used, created_at, expired_at
+`
+
+type UpdatePasswordResetParams struct {
+	ID         int64  `json:"id"`
+	SecretCode string `json:"secret_code"`
+}
+
+func (q *Queries) UpdatePasswordReset(ctx context.Context, arg UpdatePasswordResetParams) (PasswordReset, error) {
+	row := q.db.QueryRow(ctx, updatePasswordReset, arg.ID, arg.SecretCode)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
@@ -12,25 +12,75 @@ import (
 
 type Querier interface {
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	BlockSession(ctx context.Context, id uuid.UUID) (Session, error)
+	BlockSessionFamily(ctx context.Context, familyID uuid.UUID) (int64, error)
+	BlockUserSessions(ctx context.Context, username string) (int64, error)
+	ClaimOutboxTasks(ctx context.Context, arg ClaimOutboxTasksParams) ([]OutboxTask, error)
+	ConfirmUserPendingEmail(ctx context.Context, arg ConfirmUserPendingEmailParams) (User, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAccountAdjustment(ctx context.Context, arg CreateAccountAdjustmentParams) (AccountAdjustment, error)
+	CreateAccountStatusChange(ctx context.Context, arg CreateAccountStatusChangeParams) (AccountStatusChange, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error)
+	CreateOutboxTask(ctx context.Context, arg CreateOutboxTaskParams) (OutboxTask, error)
+	CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
-	DeleteAccount(ctx context.Context, id int64) error
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id int64) error
+	DisableUserTotp(ctx context.Context, username string) (User, error)
+	EnableUserTotp(ctx context.Context, arg EnableUserTotpParams) (User, error)
 	GetAccount(ctx context.Context, id int64) (Account, error)
+	GetAccountAdjustment(ctx context.Context, id int64) (AccountAdjustment, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExchangeRate(ctx context.Context, arg GetExchangeRateParams) (ExchangeRate, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetLastAccountStatusChange(ctx context.Context, accountID int64) (AccountStatusChange, error)
+	GetLatestPasswordReset(ctx context.Context, username string) (PasswordReset, error)
+	GetLatestVerifyEmail(ctx context.Context, username string) (VerifyEmail, error)
+	GetOutboxTask(ctx context.Context, id int64) (OutboxTask, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSessionAuthState(ctx context.Context, id uuid.UUID) (GetSessionAuthStateRow, error)
+	GetSessionForUpdate(ctx context.Context, id uuid.UUID) (Session, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetWebhookSubscription(ctx context.Context, id int64) (WebhookSubscription, error)
+	InvalidateUserPasswordResets(ctx context.Context, username string) (int64, error)
+	ListAccountStatusChanges(ctx context.Context, accountID int64) ([]AccountStatusChange, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListExchangeRates(ctx context.Context) ([]ExchangeRate, error)
+	ListRolePermissions(ctx context.Context) ([]RolePermission, error)
+	ListRoles(ctx context.Context) ([]Role, error)
+	ListSessions(ctx context.Context, username string) ([]Session, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context, owner string) ([]WebhookSubscription, error)
+	ListWebhookSubscriptionsForEvent(ctx context.Context, arg ListWebhookSubscriptionsForEventParams) ([]WebhookSubscription, error)
+	MarkOutboxTaskSent(ctx context.Context, id int64) error
+	NotifyAccountActivity(ctx context.Context, arg NotifyAccountActivityParams) error
+	RecordOutboxTaskFailure(ctx context.Context, arg RecordOutboxTaskFailureParams) error
+	RehashUserPassword(ctx context.Context, arg RehashUserPasswordParams) (int64, error)
+	RotateSession(ctx context.Context, id uuid.UUID) (Session, error)
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error)
+	SetUserTotpSecret(ctx context.Context, arg SetUserTotpSecretParams) (User, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error)
+	UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error)
+	UpdatePasswordReset(ctx context.Context, arg UpdatePasswordResetParams) (PasswordReset, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) (ExchangeRate, error)
+	UseUserRecoveryCode(ctx context.Context, arg UseUserRecoveryCodeParams) (int64, error)
+	UseUserTotpStep(ctx context.Context, arg UseUserTotpStepParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// ExchangeRatePrecision is the number of digits kept of an exchange rate, as in its numeric(20, 10) columns
+	ExchangeRatePrecision = 20
+	// ExchangeRateScale is the number of those digits after the decimal point
+	ExchangeRateScale = 10
+)
+
+// ErrInvalidExchangeRate is returned for an exchange rate that is not a positive decimal
+var ErrInvalidExchangeRate = errors.New("exchange rate must be a positive decimal")
+
+// exchangeRateFormat is a plain decimal, without sign, exponent or fraction bar
+var exchangeRateFormat = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// IdentityExchangeRate is the rate between a currency and itself
+func IdentityExchangeRate() pgtype.Numeric {
+	return pgtype.Numeric{Int: big.NewInt(1), Exp: 0, Valid: true}
+}
+
+// ParseExchangeRate reads a decimal such as "0.9215",
+// rounded half to even to ExchangeRateScale decimals
+func ParseExchangeRate(value string) (pgtype.Numeric, error) {
+	if !exchangeRateFormat.MatchString(value) {
+		return pgtype.Numeric{}, ErrInvalidExchangeRate
+	}
+	rate, ok := new(big.Rat).SetString(value)
+	if !ok || rate.Sign() <= 0 {
+		return pgtype.Numeric{}, ErrInvalidExchangeRate
+	}
+
+	numeric := numericFromRat(rate)
+	if numeric.Int.Sign() == 0 {
+		return pgtype.Numeric{}, fmt.Errorf("%w: %s rounds to zero", ErrInvalidExchangeRate, value)
+	}
+	if numeric.Int.Cmp(pow10(ExchangeRatePrecision)) >= 0 {
+		return pgtype.Numeric{}, fmt.Errorf("%w: %s has more than %d integer digits",
+			ErrInvalidExchangeRate, value, ExchangeRatePrecision-ExchangeRateScale)
+	}
+	return numeric, nil
+}
+
+// InvertExchangeRate returns the rate of the reverse currency pair,
+// rounded half to even to ExchangeRateScale decimals
+func InvertExchangeRate(rate pgtype.Numeric) (pgtype.Numeric, error) {
+	r, err := ratFromNumeric(rate)
+	if err != nil {
+		return pgtype.Numeric{}, err
+	}
+
+	inverse := numericFromRat(r.Inv(r))
+	if inverse.Int.Sign() == 0 {
+		return pgtype.Numeric{}, fmt.Errorf("%w: inverse rounds to zero", ErrInvalidExchangeRate)
+	}
+	return inverse, nil
+}
+
+// FormatExchangeRate writes an exchange rate as a decimal without trailing zeros
+func FormatExchangeRate(rate pgtype.Numeric) string {
+	r, err := ratFromNumeric(rate)
+	if err != nil {
+		return ""
+	}
+
+	text := r.FloatString(ExchangeRateScale)
+	for text[len(text)-1] == '0' {
+		text = text[:len(text)-1]
+	}
+	if text[len(text)-1] == '.' {
+		text = text[:len(text)-1]
+	}
+	return text
+}
+
+// convertAmount converts an amount with the exchange rate.
+// The result is rounded half to even to a whole unit, so that rounding leans neither towards the bank nor the customer.
+func convertAmount(amount int64, rate pgtype.Numeric) (int64, error) {
+	r, err := ratFromNumeric(rate)
+	if err != nil {
+		return 0, err
+	}
+
+	converted := roundHalfEven(r.Mul(r, new(big.Rat).SetInt64(amount)))
+	if !converted.IsInt64() {
+		return 0, ErrInvalidConvertedAmount
+	}
+	return converted.Int64(), nil
+}
+
+func ratFromNumeric(n pgtype.Numeric) (*big.Rat, error) {
+	if !n.Valid || n.NaN || n.InfinityModifier != pgtype.Finite || n.Int == nil {
+		return nil, ErrInvalidExchangeRate
+	}
+
+	r := new(big.Rat).SetInt(n.Int)
+	if n.Exp < 0 {
+		return r.Quo(r, new(big.Rat).SetInt(pow10(-n.Exp))), nil
+	}
+	return r.Mul(r, new(big.Rat).SetInt(pow10(n.Exp))), nil
+}
+
+// numericFromRat rounds r half to even to ExchangeRateScale decimals
+func numericFromRat(r *big.Rat) pgtype.Numeric {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(ExchangeRateScale)))
+	return pgtype.Numeric{Int: roundHalfEven(scaled), Exp: -ExchangeRateScale, Valid: true}
+}
+
+// roundHalfEven rounds r to the nearest integer, and ties to the even one
+func roundHalfEven(r *big.Rat) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+
+	// compare twice the remainder with the denominator to tell below, at or above the half
+	twice := new(big.Int).Abs(remainder)
+	twice.Lsh(twice, 1)
+	cmp := twice.Cmp(r.Denom())
+	if cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1) {
+		if r.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExchangeRate(t *testing.T) {
+	rate, err := ParseExchangeRate("0.9215")
+	require.NoError(t, err)
+	require.Equal(t, "0.9215", FormatExchangeRate(rate))
+
+	// rounded half to even to the scale of the column
+	rate, err = ParseExchangeRate("1.00000000005")
+	require.NoError(t, err)
+	require.Equal(t, "1", FormatExchangeRate(rate))
+
+	rate, err = ParseExchangeRate("1.00000000015")
+	require.NoError(t, err)
+	require.Equal(t, "1.0000000002", FormatExchangeRate(rate))
+
+	rate, err = ParseExchangeRate("9999999999.9999999999")
+	require.NoError(t, err)
+	require.Equal(t, "9999999999.9999999999", FormatExchangeRate(rate))
+
+	for _, value := range []string{
+		"", "abc", "0", "-1.5", "0.00000000001",
+		// plain decimals only
+		"1/3", "1e3", "+1.5", ".5", "1.", " 1",
+		// beyond the 10 integer digits of the column, also once rounded
+		"10000000000", "9999999999.99999999995",
+	} {
+		_, err = ParseExchangeRate(value)
+		require.ErrorIs(t, err, ErrInvalidExchangeRate, value)
+	}
+}
+
+func TestInvertExchangeRate(t *testing.T) {
+	inverse, err := InvertExchangeRate(mustParseExchangeRate(t, "0.8"))
+	require.NoError(t, err)
+	require.Equal(t, "1.25", FormatExchangeRate(inverse))
+
+	inverse, err = InvertExchangeRate(mustParseExchangeRate(t, "3"))
+	require.NoError(t, err)
+	require.Equal(t, "0.3333333333", FormatExchangeRate(inverse))
+}
+
+func TestConvertAmount(t *testing.T) {
+	testCases := []struct {
+		amount   int64
+		rate     string
+		expected int64
+	}{
+		{amount: 100, rate: "1", expected: 100},
+		{amount: 100, rate: "0.913", expected: 91},
+		{amount: 100, rate: "0.917", expected: 92},
+		// ties go to the even unit
+		{amount: 100, rate: "0.915", expected: 92},
+		{amount: 100, rate: "0.925", expected: 92},
+		{amount: 1, rate: "0.5", expected: 0},
+		{amount: 3, rate: "0.5", expected: 2},
+		// no precision is lost on large amounts
+		{amount: 9007199254740993, rate: "1", expected: 9007199254740993},
+	}
+
+	for _, tc := range testCases {
+		converted, err := convertAmount(tc.amount, mustParseExchangeRate(t, tc.rate))
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, converted, "%d * %s", tc.amount, tc.rate)
+	}
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+type RelayOutboxParams struct {
+	Limit int32
+	// MaxAttempts is the number of failed publishes after which a task is left pending for good
+	MaxAttempts int32
+	// ClaimTimeout is how long the claimed tasks are hidden from other relays,
+	// after which they are published again if this relay did not get to mark them.
+	ClaimTimeout time.Duration
+	// RetryDelay returns how long to wait before publishing a task again after its nth failure
+	RetryDelay func(attempts int32) time.Duration
+	// Publish hands a pending task to the task queue
+	Publish func(task OutboxTask) error
+}
+
+type RelayOutboxResult struct {
+	Sent   int
+	Failed int
+}
+
+// RelayOutbox publishes up to Limit due outbox tasks and marks them sent.
+// The tasks are claimed in a transaction of their own, with SKIP LOCKED so that several relays can run at the same time,
+// and published after it commits, so that no row stays locked while the task queue is slow.
+// A task that fails to publish stays pending and is retried after RetryDelay, until it has failed MaxAttempts times.
+func (store *SQLStore) RelayOutbox(ctx context.Context, arg RelayOutboxParams) (RelayOutboxResult, error) {
+	var result RelayOutboxResult
+
+	tasks, err := store.ClaimOutboxTasks(ctx, ClaimOutboxTasksParams{
+		ClaimedUntil: time.Now().Add(arg.ClaimTimeout),
+		MaxAttempts:  arg.MaxAttempts,
+		TaskLimit:    arg.Limit,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	for _, task := range tasks {
+		publishErr := arg.Publish(task)
+		if publishErr != nil {
+			result.Failed++
+			err = store.RecordOutboxTaskFailure(ctx, RecordOutboxTaskFailureParams{
+				ID:            task.ID,
+				LastError:     publishErr.Error(),
+				NextAttemptAt: time.Now().Add(arg.RetryDelay(task.Attempts + 1)),
+			})
+			if err != nil {
+				return result, err
+			}
+			continue
+		}
+
+		// if this fails the task is published again once its claim expires, the delivery is at least once
+		err = store.MarkOutboxTaskSent(ctx, task.ID)
+		if err != nil {
+			return result, err
+		}
+		result.Sent++
+	}
+
+	return result, nil
+}
@@ -0,0 +1,209 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func newRelayOutboxParams(publish func(task OutboxTask) error) RelayOutboxParams {
+	return RelayOutboxParams{
+		Limit:        1000,
+		MaxAttempts:  2,
+		ClaimTimeout: time.Minute,
+		RetryDelay: func(attempts int32) time.Duration {
+			return 0
+		},
+		Publish: publish,
+	}
+}
+
+func newRandomOutboxTask(username string) CreateOutboxTaskParams {
+	return CreateOutboxTaskParams{
+		TaskType:  "task:send_verify_email",
+		Payload:   []byte(fmt.Sprintf(`{"username": %q}`, username)),
+		Queue:     "critical",
+		MaxRetry:  10,
+		ProcessAt: time.Now(),
+	}
+}
+
+func TestCreateUserTxWritesOutboxTasks(t *testing.T) {
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	var task OutboxTask
+	result, err := testStore.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       util.RandomOwner(),
+			HashedPassword: hashedPassword,
+			FullName:       util.RandomOwner(),
+			Email:          util.RandomEmail(),
+		},
+		AfterCreate: func(user User) ([]CreateOutboxTaskParams, error) {
+			return []CreateOutboxTaskParams{newRandomOutboxTask(user.Username)}, nil
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.RelayOutbox(context.Background(), newRelayOutboxParams(func(pending OutboxTask) error {
+		if string(pending.Payload) == fmt.Sprintf(`{"username": %q}`, result.User.Username) {
+			task = pending
+		}
+		return nil
+	}))
+	require.NoError(t, err)
+	require.NotZero(t, task.ID)
+
+	task, err = testStore.GetOutboxTask(context.Background(), task.ID)
+	require.NoError(t, err)
+	require.True(t, task.SentAt.Valid)
+}
+
+func TestCreateUserTxRollsBackOutboxTasks(t *testing.T) {
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	_, err = testStore.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       username,
+			HashedPassword: hashedPassword,
+			FullName:       util.RandomOwner(),
+			Email:          util.RandomEmail(),
+		},
+		AfterCreate: func(user User) ([]CreateOutboxTaskParams, error) {
+			return nil, errors.New("cannot marshal payload")
+		},
+	})
+	require.Error(t, err)
+
+	_, err = testStore.GetUser(context.Background(), username)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestRelayOutboxPublishFailure(t *testing.T) {
+	user := createRandomUser(t)
+
+	task, err := testStore.CreateOutboxTask(context.Background(), newRandomOutboxTask(user.Username))
+	require.NoError(t, err)
+	require.False(t, task.SentAt.Valid)
+
+	failTask := func(pending OutboxTask) error {
+		if pending.ID == task.ID {
+			return errors.New("redis is down")
+		}
+		return nil
+	}
+
+	arg := newRelayOutboxParams(failTask)
+	arg.RetryDelay = func(attempts int32) time.Duration {
+		return time.Hour
+	}
+	result, err := testStore.RelayOutbox(context.Background(), arg)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, result.Failed, 1)
+
+	task, err = testStore.GetOutboxTask(context.Background(), task.ID)
+	require.NoError(t, err)
+	require.False(t, task.SentAt.Valid)
+	require.Equal(t, int32(1), task.Attempts)
+	require.Equal(t, "redis is down", task.LastError)
+	require.WithinDuration(t, time.Now().Add(time.Hour), task.NextAttemptAt, time.Minute)
+
+	// the task is not published again before its retry delay
+	published := false
+	_, err = testStore.RelayOutbox(context.Background(), newRelayOutboxParams(func(pending OutboxTask) error {
+		published = published || pending.ID == task.ID
+		return nil
+	}))
+	require.NoError(t, err)
+	require.False(t, published)
+}
+
+func TestRelayOutboxRetry(t *testing.T) {
+	user := createRandomUser(t)
+
+	task, err := testStore.CreateOutboxTask(context.Background(), newRandomOutboxTask(user.Username))
+	require.NoError(t, err)
+
+	failTask := func(pending OutboxTask) error {
+		if pending.ID == task.ID {
+			return errors.New("redis is down")
+		}
+		return nil
+	}
+
+	_, err = testStore.RelayOutbox(context.Background(), newRelayOutboxParams(failTask))
+	require.NoError(t, err)
+
+	// the task is published on the next run
+	_, err = testStore.RelayOutbox(context.Background(), newRelayOutboxParams(func(pending OutboxTask) error {
+		return nil
+	}))
+	require.NoError(t, err)
+
+	task, err = testStore.GetOutboxTask(context.Background(), task.ID)
+	require.NoError(t, err)
+	require.True(t, task.SentAt.Valid)
+	require.Equal(t, int32(1), task.Attempts)
+}
+
+func TestRelayOutboxMaxAttempts(t *testing.T) {
+	user := createRandomUser(t)
+
+	task, err := testStore.CreateOutboxTask(context.Background(), newRandomOutboxTask(user.Username))
+	require.NoError(t, err)
+
+	failTask := func(pending OutboxTask) error {
+		if pending.ID == task.ID {
+			return errors.New("redis is down")
+		}
+		return nil
+	}
+
+	// MaxAttempts is 2
+	for i := 0; i < 3; i++ {
+		_, err = testStore.RelayOutbox(context.Background(), newRelayOutboxParams(failTask))
+		require.NoError(t, err)
+	}
+
+	task, err = testStore.GetOutboxTask(context.Background(), task.ID)
+	require.NoError(t, err)
+	require.False(t, task.SentAt.Valid)
+	require.Equal(t, int32(2), task.Attempts)
+}
+
+func TestRelayOutboxClaim(t *testing.T) {
+	user := createRandomUser(t)
+
+	task, err := testStore.CreateOutboxTask(context.Background(), newRandomOutboxTask(user.Username))
+	require.NoError(t, err)
+
+	claimed, err := testStore.ClaimOutboxTasks(context.Background(), ClaimOutboxTasksParams{
+		ClaimedUntil: time.Now().Add(time.Minute),
+		MaxAttempts:  2,
+		TaskLimit:    1000,
+	})
+	require.NoError(t, err)
+
+	claimedIDs := make([]int64, len(claimed))
+	for i, claimedTask := range claimed {
+		claimedIDs[i] = claimedTask.ID
+	}
+	require.Contains(t, claimedIDs, task.ID)
+
+	// another relay does not see a claimed task, even though it was never marked
+	published := false
+	_, err = testStore.RelayOutbox(context.Background(), newRelayOutboxParams(func(pending OutboxTask) error {
+		published = published || pending.ID == task.ID
+		return nil
+	}))
+	require.NoError(t, err)
+	require.False(t, published)
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRoles(t *testing.T) {
+	roles, err := testStore.ListRoles(context.Background())
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	require.Subset(t, names, []string{util.BankerRole, util.DepositorRole})
+}
+
+func TestListRolePermissions(t *testing.T) {
+	rolePermissions, err := testStore.ListRolePermissions(context.Background())
+	require.NoError(t, err)
+
+	// the seeded permissions match the defaults used without a database
+	permissions := make(map[string][]string)
+	for _, rolePermission := range rolePermissions {
+		permissions[rolePermission.Role] = append(permissions[rolePermission.Role], rolePermission.Permission)
+	}
+	for role, defaults := range util.DefaultRolePermissions {
+		require.ElementsMatch(t, defaults, permissions[role])
+	}
+}
+
+func TestUpdateUserRoleTx(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	_, err := testStore.UpdateUserRoleTx(context.Background(), UpdateUserRoleTxParams{
+		Username: user.Username,
+		Role:     util.RandomString(8),
+	})
+	require.Equal(t, ForeignKeyViolation, ErrorCode(err))
+
+	result, err := testStore.UpdateUserRoleTx(context.Background(), UpdateUserRoleTxParams{
+		Username: user.Username,
+		Role:     util.BankerRole,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.BankerRole, result.User.Role)
+
+	// tokens carrying the old role cannot be renewed
+	session, err = testStore.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsBlocked)
+
+	_, err = testStore.UpdateUserRoleTx(context.Background(), UpdateUserRoleTxParams{
+		Username: util.RandomOwner(),
+		Role:     util.BankerRole,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSession(t *testing.T, user User) Session {
+	arg := CreateSessionParams{
+		ID:           uuid.New(),
+		Username:     user.Username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    util.RandomString(10),
+		ClientIp:     "127.0.0.1",
+		IsBlocked:    false,
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+
+	session, err := testStore.CreateSession(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ID, session.ID)
+	require.Equal(t, arg.Username, session.Username)
+	require.False(t, session.IsBlocked)
+
+	return session
+}
+
+func TestBlockSession(t *testing.T) {
+	user := createRandomUser(t)
+	session1 := createRandomSession(t, user)
+
+	session2, err := testStore.BlockSession(context.Background(), session1.ID)
+	require.NoError(t, err)
+	require.Equal(t, session1.ID, session2.ID)
+	require.True(t, session2.IsBlocked)
+}
+
+func TestListSessions(t *testing.T) {
+	user := createRandomUser(t)
+	for i := 0; i < 3; i++ {
+		createRandomSession(t, user)
+	}
+
+	blockedSession := createRandomSession(t, user)
+	_, err := testStore.BlockSession(context.Background(), blockedSession.ID)
+	require.NoError(t, err)
+
+	sessions, err := testStore.ListSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+	for _, session := range sessions {
+		require.Equal(t, user.Username, session.Username)
+		require.NotEqual(t, blockedSession.ID, session.ID)
+	}
+}
+
+func TestBlockUserSessions(t *testing.T) {
+	user := createRandomUser(t)
+	for i := 0; i < 3; i++ {
+		createRandomSession(t, user)
+	}
+
+	blocked, err := testStore.BlockUserSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), blocked)
+
+	sessions, err := testStore.ListSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+func TestGetSessionAuthState(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	state, err := testStore.GetSessionAuthState(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.Equal(t, user.Username, state.Username)
+	require.False(t, state.IsBlocked)
+	require.WithinDuration(t, user.PasswordChangedAt, state.PasswordChangedAt, time.Second)
+
+	_, err = testStore.BlockSession(context.Background(), session.ID)
+	require.NoError(t, err)
+
+	state, err = testStore.GetSessionAuthState(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, state.IsBlocked)
+}
@@ -10,20 +10,42 @@ import (
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	CrossCurrencyTransferTx(ctx context.Context, arg CrossCurrencyTransferTxParams) (TransferTxResult, error)
+	CreateAccountTx(ctx context.Context, arg CreateAccountTxParams) (CreateAccountTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
+	UpdateUserTx(ctx context.Context, arg UpdateUserTxParams) (UpdateUserTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	CreateSessionTx(ctx context.Context, arg CreateSessionTxParams) (CreateSessionTxResult, error)
+	RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (RotateSessionTxResult, error)
+	ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error)
+	EnableUserTotpTx(ctx context.Context, arg EnableUserTotpTxParams) (EnableUserTotpTxResult, error)
+	DisableUserTotpTx(ctx context.Context, arg DisableUserTotpTxParams) (DisableUserTotpTxResult, error)
+	RecordLoginFailureTx(ctx context.Context, arg RecordLoginFailureTxParams) error
+	LogoutSessionTx(ctx context.Context, arg BlockSessionTxParams) (BlockSessionTxResult, error)
+	RevokeSessionTx(ctx context.Context, arg BlockSessionTxParams) (BlockSessionTxResult, error)
+	RevokeAllSessionsTx(ctx context.Context, arg RevokeAllSessionsTxParams) (RevokeAllSessionsTxResult, error)
+	UpdateUserRoleTx(ctx context.Context, arg UpdateUserRoleTxParams) (UpdateUserRoleTxResult, error)
+	AdjustAccountBalanceTx(ctx context.Context, arg AdjustAccountBalanceTxParams) (AdjustAccountBalanceTxResult, error)
+	ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParams) (ChangeAccountStatusTxResult, error)
+	CloseAccountTx(ctx context.Context, arg CloseAccountTxParams) (CloseAccountTxResult, error)
+	ReopenAccountTx(ctx context.Context, arg ReopenAccountTxParams) (ReopenAccountTxResult, error)
+	SetExchangeRateTx(ctx context.Context, arg SetExchangeRateTxParams) (SetExchangeRateTxResult, error)
+	RelayOutbox(ctx context.Context, arg RelayOutboxParams) (RelayOutboxResult, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
 type SQLStore struct {
-	connPool *pgxpool.Pool
+	connPool  *pgxpool.Pool
+	eventTask EventTaskOptions
 	*Queries
 }
 
-// NewStore creates a new store
-func NewStore(connPool *pgxpool.Pool) Store {
+// NewStore creates a new store.
+// Domain events are delivered to webhooks by the eventTask written to the outbox.
+func NewStore(connPool *pgxpool.Pool, eventTask EventTaskOptions) Store {
 	return &SQLStore{
-		connPool: connPool,
-		Queries:  New(connPool),
+		connPool:  connPool,
+		eventTask: eventTask,
+		Queries:   New(connPool),
 	}
 }
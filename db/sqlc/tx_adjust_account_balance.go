@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+// AdjustAccountBalanceTxParams contains the input parameters of the adjustment transaction
+type AdjustAccountBalanceTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// Amount is added to the balance, it is negative to take money out.
+	Amount int64  `json:"amount"`
+	Reason string `json:"reason"`
+	// Audit.Actor is recorded as the banker who posted the adjustment.
+	Audit AuditMetadata `json:"-"`
+}
+
+// AdjustAccountBalanceTxResult is the result of the adjustment transaction
+type AdjustAccountBalanceTxResult struct {
+	Adjustment AccountAdjustment `json:"adjustment"`
+	Account    Account           `json:"account"`
+	Entry      Entry             `json:"entry"`
+}
+
+// AdjustAccountBalanceTx posts a manual adjustment to an account.
+// The balance is updated with an entry like a transfer does, and the adjustment records who posted it and why.
+// Listeners of AccountActivityChannel are notified about the entry when the transaction commits.
+// It returns ErrInsufficientFunds if the adjustment would take the account below its overdraft limit,
+// and ErrAccountNotActive if the account is closed.
+func (store *SQLStore) AdjustAccountBalanceTx(ctx context.Context, arg AdjustAccountBalanceTxParams) (AdjustAccountBalanceTxResult, error) {
+	var result AdjustAccountBalanceTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     arg.AccountID,
+			Amount: arg.Amount,
+		})
+		if ErrorCode(err) == CheckViolation {
+			return ErrInsufficientFunds
+		}
+		if err != nil {
+			return err
+		}
+
+		// a closed account must keep a zero balance, frozen accounts can still be corrected
+		if result.Account.Status == util.AccountStatusClosed {
+			return fmt.Errorf("account [%d] is closed: %w", result.Account.ID, ErrAccountNotActive)
+		}
+
+		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: arg.AccountID,
+			Amount:    arg.Amount,
+			Balance:   result.Account.Balance,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = recordAccountActivity(ctx, q, result.Account, result.Entry)
+		if err != nil {
+			return err
+		}
+
+		result.Adjustment, err = q.CreateAccountAdjustment(ctx, CreateAccountAdjustmentParams{
+			AccountID: arg.AccountID,
+			EntryID:   result.Entry.ID,
+			Amount:    arg.Amount,
+			Reason:    arg.Reason,
+			CreatedBy: arg.Audit.Actor,
+		})
+		if err != nil {
+			return err
+		}
+
+		account := result.Account
+		account.Balance -= arg.Amount
+		accountID := strconv.FormatInt(account.ID, 10)
+		return recordAuditEvent(ctx, q, arg.Audit, util.AuditActionAccountAdjust, util.AuditTargetAccount, accountID, account, result.Account)
+	})
+
+	return result, err
+}
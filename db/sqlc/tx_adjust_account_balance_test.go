@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdjustAccountBalanceTx(t *testing.T) {
+	account := createRandomAccountWithBalance(t, 100)
+	banker := createRandomUser(t)
+
+	result, err := testStore.AdjustAccountBalanceTx(context.Background(), AdjustAccountBalanceTxParams{
+		AccountID: account.ID,
+		Amount:    -30,
+		Reason:    "reverse duplicated card fee",
+		Audit:     AuditMetadata{Actor: banker.Username},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(70), result.Account.Balance)
+
+	require.Equal(t, account.ID, result.Entry.AccountID)
+	require.Equal(t, int64(-30), result.Entry.Amount)
+	require.Equal(t, int64(70), result.Entry.Balance)
+
+	require.Equal(t, account.ID, result.Adjustment.AccountID)
+	require.Equal(t, result.Entry.ID, result.Adjustment.EntryID)
+	require.Equal(t, int64(-30), result.Adjustment.Amount)
+	require.Equal(t, "reverse duplicated card fee", result.Adjustment.Reason)
+	require.Equal(t, banker.Username, result.Adjustment.CreatedBy)
+
+	adjustment, err := testStore.GetAccountAdjustment(context.Background(), result.Adjustment.ID)
+	require.NoError(t, err)
+	require.Equal(t, result.Adjustment.ID, adjustment.ID)
+}
+
+func TestAdjustAccountBalanceTxInsufficientFunds(t *testing.T) {
+	account := createRandomAccountWithBalance(t, 10)
+	banker := createRandomUser(t)
+
+	_, err := testStore.AdjustAccountBalanceTx(context.Background(), AdjustAccountBalanceTxParams{
+		AccountID: account.ID,
+		Amount:    -11,
+		Reason:    "chargeback",
+		Audit:     AuditMetadata{Actor: banker.Username},
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// nothing must have been written
+	updatedAccount, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance, updatedAccount.Balance)
+}
+
+func TestAdjustAccountBalanceTxAccountNotFound(t *testing.T) {
+	banker := createRandomUser(t)
+
+	_, err := testStore.AdjustAccountBalanceTx(context.Background(), AdjustAccountBalanceTxParams{
+		AccountID: -1,
+		Amount:    10,
+		Reason:    "goodwill credit",
+		Audit:     AuditMetadata{Actor: banker.Username},
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
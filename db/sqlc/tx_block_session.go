@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+type BlockSessionTxParams struct {
+	SessionID uuid.UUID
+	Audit     AuditMetadata
+}
+
+type BlockSessionTxResult struct {
+	Session Session
+}
+
+// LogoutSessionTx blocks the session the user logs out of, and records the logout in the audit log
+func (store *SQLStore) LogoutSessionTx(ctx context.Context, arg BlockSessionTxParams) (BlockSessionTxResult, error) {
+	return store.blockSessionTx(ctx, arg, util.AuditActionSessionLogout)
+}
+
+// RevokeSessionTx blocks a session on request of its user, and records it in the audit log
+func (store *SQLStore) RevokeSessionTx(ctx context.Context, arg BlockSessionTxParams) (BlockSessionTxResult, error) {
+	return store.blockSessionTx(ctx, arg, util.AuditActionSessionRevoke)
+}
+
+func (store *SQLStore) blockSessionTx(ctx context.Context, arg BlockSessionTxParams, action string) (BlockSessionTxResult, error) {
+	var result BlockSessionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		session, err := q.GetSessionForUpdate(ctx, arg.SessionID)
+		if err != nil {
+			return err
+		}
+
+		result.Session, err = q.BlockSession(ctx, session.ID)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, arg.Audit, action, util.AuditTargetSession, session.ID.String(), session, result.Session)
+	})
+
+	return result, err
+}
+
+type RevokeAllSessionsTxParams struct {
+	Username string
+	Audit    AuditMetadata
+}
+
+type RevokeAllSessionsTxResult struct {
+	RevokedSessions int64 `json:"revoked_sessions"`
+}
+
+// RevokeAllSessionsTx blocks every session of the user, and records how many were revoked in the audit log
+func (store *SQLStore) RevokeAllSessionsTx(ctx context.Context, arg RevokeAllSessionsTxParams) (RevokeAllSessionsTxResult, error) {
+	var result RevokeAllSessionsTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.RevokedSessions, err = q.BlockUserSessions(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, arg.Audit, util.AuditActionUserRevokeAllSessions, util.AuditTargetUser, arg.Username, nil, result)
+	})
+
+	return result, err
+}
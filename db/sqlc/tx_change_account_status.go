@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+// ChangeAccountStatusTxParams contains the input parameters of the status change transaction
+type ChangeAccountStatusTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// FromStatus is the status the account must currently have.
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Reason     string `json:"reason"`
+	// Audit.Actor is recorded as the user who changed the status.
+	Audit AuditMetadata `json:"-"`
+}
+
+// ChangeAccountStatusTxResult is the result of the status change transaction
+type ChangeAccountStatusTxResult struct {
+	Account      Account             `json:"account"`
+	StatusChange AccountStatusChange `json:"status_change"`
+}
+
+// ChangeAccountStatusTx moves an account from one status to another and records who did it and why.
+// It returns ErrAccountStatusTransition if the account is not in FromStatus or cannot move to ToStatus.
+// Closing an account goes through CloseAccountTx instead, which deals with the remaining balance,
+// and reopening it through ReopenAccountTx, which restores the status it was closed from.
+func (store *SQLStore) ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParams) (ChangeAccountStatusTxResult, error) {
+	var result ChangeAccountStatusTxResult
+
+	if arg.ToStatus == util.AccountStatusClosed {
+		return result, fmt.Errorf("use CloseAccountTx to close an account: %w", ErrAccountStatusTransition)
+	}
+	if arg.FromStatus == util.AccountStatusClosed {
+		return result, fmt.Errorf("use ReopenAccountTx to reopen an account: %w", ErrAccountStatusTransition)
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Status != arg.FromStatus {
+			return fmt.Errorf("account [%d] is %s, not %s: %w", account.ID, account.Status, arg.FromStatus, ErrAccountStatusTransition)
+		}
+
+		result.Account, result.StatusChange, err = changeAccountStatus(ctx, q, account, arg.ToStatus, arg.Reason, arg.Audit)
+		return err
+	})
+
+	return result, err
+}
+
+// changeAccountStatus updates the status of an account locked by the caller and records the change,
+// both as an account status change and in the audit log
+func changeAccountStatus(
+	ctx context.Context,
+	q *Queries,
+	account Account,
+	status string,
+	reason string,
+	audit AuditMetadata,
+) (Account, AccountStatusChange, error) {
+	if !util.IsAllowedAccountStatusTransition(account.Status, status) {
+		err := fmt.Errorf("account [%d] cannot go from %s to %s: %w", account.ID, account.Status, status, ErrAccountStatusTransition)
+		return account, AccountStatusChange{}, err
+	}
+
+	updatedAccount, err := q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+		ID:     account.ID,
+		Status: status,
+	})
+	if err != nil {
+		return account, AccountStatusChange{}, err
+	}
+
+	statusChange, err := q.CreateAccountStatusChange(ctx, CreateAccountStatusChangeParams{
+		AccountID:  account.ID,
+		FromStatus: account.Status,
+		ToStatus:   status,
+		Reason:     reason,
+		ChangedBy:  audit.Actor,
+	})
+	if err != nil {
+		return updatedAccount, statusChange, err
+	}
+
+	action := util.AuditActionAccountChangeStatus
+	if status == util.AccountStatusClosed {
+		action = util.AuditActionAccountClose
+	}
+
+	accountID := strconv.FormatInt(account.ID, 10)
+	err = recordAuditEvent(ctx, q, audit, action, util.AuditTargetAccount, accountID, account, updatedAccount)
+	return updatedAccount, statusChange, err
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeAccountStatusTx(t *testing.T) {
+	account := createRandomAccount(t)
+	banker := createRandomUser(t)
+
+	result, err := testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: util.AccountStatusActive,
+		ToStatus:   util.AccountStatusFrozen,
+		Reason:     "suspected card fraud",
+		Audit:      AuditMetadata{Actor: banker.Username},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountStatusFrozen, result.Account.Status)
+	require.Equal(t, account.ID, result.StatusChange.AccountID)
+	require.Equal(t, util.AccountStatusActive, result.StatusChange.FromStatus)
+	require.Equal(t, util.AccountStatusFrozen, result.StatusChange.ToStatus)
+	require.Equal(t, "suspected card fraud", result.StatusChange.Reason)
+	require.Equal(t, banker.Username, result.StatusChange.ChangedBy)
+
+	// the account is not active anymore
+	_, err = testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: util.AccountStatusActive,
+		ToStatus:   util.AccountStatusFrozen,
+		Reason:     "suspected card fraud",
+		Audit:      AuditMetadata{Actor: banker.Username},
+	})
+	require.ErrorIs(t, err, ErrAccountStatusTransition)
+
+	// closing needs CloseAccountTx
+	_, err = testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: util.AccountStatusFrozen,
+		ToStatus:   util.AccountStatusClosed,
+		Reason:     "fraud confirmed",
+		Audit:      AuditMetadata{Actor: banker.Username},
+	})
+	require.ErrorIs(t, err, ErrAccountStatusTransition)
+
+	statusChanges, err := testStore.ListAccountStatusChanges(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, statusChanges, 1)
+	require.Equal(t, result.StatusChange.ID, statusChanges[0].ID)
+}
@@ -0,0 +1,131 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+// CloseAccountTxParams contains the input parameters of the close account transaction
+type CloseAccountTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// FromStatus is optional. When set, it is the status the account must currently have.
+	FromStatus string `json:"from_status"`
+	// SweepToAccountID is optional. When set, a positive balance is transferred to that account before closing.
+	SweepToAccountID int64 `json:"sweep_to_account_id"`
+	// ExchangeRate converts the swept balance to the currency of SweepToAccountID, it is 1 for the same currency.
+	ExchangeRate pgtype.Numeric `json:"exchange_rate"`
+	Reason       string         `json:"reason"`
+	// Audit.Actor is recorded as the user who closed the account, and who made the sweep.
+	Audit AuditMetadata `json:"-"`
+}
+
+// CloseAccountTxResult is the result of the close account transaction
+type CloseAccountTxResult struct {
+	Account      Account             `json:"account"`
+	StatusChange AccountStatusChange `json:"status_change"`
+	// Sweep is nil when the balance was already zero.
+	Sweep *TransferTxResult `json:"sweep"`
+}
+
+// CloseAccountTx closes an account, keeping its rows so that entries and transfers still refer to it.
+// The balance must be zero, or positive with a SweepToAccountID to move it to. Only an active account can be swept.
+// It returns ErrAccountBalanceNotZero if money would be left in or owed by the closed account.
+func (store *SQLStore) CloseAccountTx(ctx context.Context, arg CloseAccountTxParams) (CloseAccountTxResult, error) {
+	var result CloseAccountTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		var account Account
+
+		// lock both accounts in the same order as transfers do
+		switch {
+		case arg.SweepToAccountID == 0:
+			account, err = q.GetAccountForUpdate(ctx, arg.AccountID)
+		case arg.AccountID < arg.SweepToAccountID:
+			account, _, err = lockAccounts(ctx, q, arg.AccountID, arg.SweepToAccountID)
+		default:
+			_, account, err = lockAccounts(ctx, q, arg.SweepToAccountID, arg.AccountID)
+		}
+		if err != nil {
+			return err
+		}
+
+		if arg.FromStatus != "" && account.Status != arg.FromStatus {
+			return fmt.Errorf("account [%d] is %s, not %s: %w", account.ID, account.Status, arg.FromStatus, ErrAccountStatusTransition)
+		}
+
+		if !util.IsAllowedAccountStatusTransition(account.Status, util.AccountStatusClosed) {
+			return fmt.Errorf("account [%d] is already %s: %w", account.ID, account.Status, ErrAccountStatusTransition)
+		}
+
+		if account.Balance < 0 || (account.Balance > 0 && arg.SweepToAccountID == 0) {
+			return fmt.Errorf("account [%d] balance is %d: %w", account.ID, account.Balance, ErrAccountBalanceNotZero)
+		}
+
+		if account.Balance > 0 {
+			sweep, err := store.transfer(ctx, q, TransferTxParams{
+				FromAccountID: arg.AccountID,
+				ToAccountID:   arg.SweepToAccountID,
+				Amount:        account.Balance,
+				Audit:         arg.Audit,
+			}, arg.ExchangeRate)
+			if err != nil {
+				return err
+			}
+
+			result.Sweep = &sweep
+			account = sweep.FromAccount
+		}
+
+		result.Account, result.StatusChange, err = changeAccountStatus(ctx, q, account, util.AccountStatusClosed, arg.Reason, arg.Audit)
+		return err
+	})
+
+	return result, err
+}
+
+// ReopenAccountTxParams contains the input parameters of the reopen account transaction
+type ReopenAccountTxParams struct {
+	AccountID int64  `json:"account_id"`
+	Reason    string `json:"reason"`
+	// Audit.Actor is recorded as the user who reopened the account.
+	Audit AuditMetadata `json:"-"`
+}
+
+// ReopenAccountTxResult is the result of the reopen account transaction
+type ReopenAccountTxResult struct {
+	Account      Account             `json:"account"`
+	StatusChange AccountStatusChange `json:"status_change"`
+}
+
+// ReopenAccountTx moves a closed account back to the status it had before it was closed,
+// so that closing and reopening a frozen account does not lift the freeze.
+// It returns ErrAccountStatusTransition if the account is not closed.
+func (store *SQLStore) ReopenAccountTx(ctx context.Context, arg ReopenAccountTxParams) (ReopenAccountTxResult, error) {
+	var result ReopenAccountTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Status != util.AccountStatusClosed {
+			return fmt.Errorf("account [%d] is %s, not %s: %w", account.ID, account.Status, util.AccountStatusClosed, ErrAccountStatusTransition)
+		}
+
+		// the last change of a closed account is the one that closed it
+		lastChange, err := q.GetLastAccountStatusChange(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Account, result.StatusChange, err = changeAccountStatus(ctx, q, account, lastChange.FromStatus, arg.Reason, arg.Audit)
+		return err
+	})
+
+	return result, err
+}
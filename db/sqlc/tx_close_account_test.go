@@ -0,0 +1,191 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseAccountTx(t *testing.T) {
+	account := createRandomAccountWithBalance(t, 0)
+
+	result, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID: account.ID,
+		Reason:    "no longer needed",
+		Audit:     AuditMetadata{Actor: account.Owner},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountStatusClosed, result.Account.Status)
+	require.Equal(t, util.AccountStatusActive, result.StatusChange.FromStatus)
+	require.Equal(t, util.AccountStatusClosed, result.StatusChange.ToStatus)
+	require.Nil(t, result.Sweep)
+
+	// a closed account cannot be closed again
+	_, err = testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID: account.ID,
+		Reason:    "no longer needed",
+		Audit:     AuditMetadata{Actor: account.Owner},
+	})
+	require.ErrorIs(t, err, ErrAccountStatusTransition)
+
+	// but a banker can reopen it
+	reopened, err := testStore.ReopenAccountTx(context.Background(), ReopenAccountTxParams{
+		AccountID: account.ID,
+		Reason:    "closed by mistake",
+		Audit:     AuditMetadata{Actor: account.Owner},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountStatusActive, reopened.Account.Status)
+	require.Equal(t, util.AccountStatusClosed, reopened.StatusChange.FromStatus)
+
+	// which only works on a closed account
+	_, err = testStore.ReopenAccountTx(context.Background(), ReopenAccountTxParams{
+		AccountID: account.ID,
+		Reason:    "closed by mistake",
+		Audit:     AuditMetadata{Actor: account.Owner},
+	})
+	require.ErrorIs(t, err, ErrAccountStatusTransition)
+}
+
+func TestReopenAccountTxKeepsFreeze(t *testing.T) {
+	account := createRandomAccountWithBalance(t, 0)
+	banker := createRandomUser(t)
+
+	_, err := testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: util.AccountStatusActive,
+		ToStatus:   util.AccountStatusFrozen,
+		Reason:     "suspected card fraud",
+		Audit:      AuditMetadata{Actor: banker.Username},
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:  account.ID,
+		FromStatus: util.AccountStatusFrozen,
+		Reason:     "no longer needed",
+		Audit:      AuditMetadata{Actor: banker.Username},
+	})
+	require.NoError(t, err)
+
+	// the account was frozen when it was closed, so it stays frozen
+	reopened, err := testStore.ReopenAccountTx(context.Background(), ReopenAccountTxParams{
+		AccountID: account.ID,
+		Reason:    "closed by mistake",
+		Audit:     AuditMetadata{Actor: banker.Username},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountStatusFrozen, reopened.Account.Status)
+	require.Equal(t, util.AccountStatusFrozen, reopened.StatusChange.ToStatus)
+}
+
+func TestCloseAccountTxStatusChanged(t *testing.T) {
+	account := createRandomAccountWithBalance(t, 0)
+	banker := createRandomUser(t)
+
+	_, err := testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: util.AccountStatusActive,
+		ToStatus:   util.AccountStatusFrozen,
+		Reason:     "suspected card fraud",
+		Audit:      AuditMetadata{Actor: banker.Username},
+	})
+	require.NoError(t, err)
+
+	// the owner was allowed to close the account while it was still active
+	_, err = testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:  account.ID,
+		FromStatus: util.AccountStatusActive,
+		Reason:     "no longer needed",
+		Audit:      AuditMetadata{Actor: account.Owner},
+	})
+	require.ErrorIs(t, err, ErrAccountStatusTransition)
+
+	updatedAccount, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.AccountStatusFrozen, updatedAccount.Status)
+}
+
+func TestCloseAccountTxBalanceNotZero(t *testing.T) {
+	account := createRandomAccountWithBalance(t, 10)
+
+	_, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID: account.ID,
+		Reason:    "no longer needed",
+		Audit:     AuditMetadata{Actor: account.Owner},
+	})
+	require.ErrorIs(t, err, ErrAccountBalanceNotZero)
+
+	updatedAccount, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.AccountStatusActive, updatedAccount.Status)
+}
+
+func TestCloseAccountTxSweep(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 10)
+	account2 := createRandomAccountWithBalance(t, 20)
+
+	result, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:        account1.ID,
+		SweepToAccountID: account2.ID,
+		ExchangeRate:     IdentityExchangeRate(),
+		Reason:           "moving to another bank",
+		Audit:            AuditMetadata{Actor: account1.Owner},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountStatusClosed, result.Account.Status)
+	require.Zero(t, result.Account.Balance)
+
+	require.NotNil(t, result.Sweep)
+	require.Equal(t, int64(10), result.Sweep.Transfer.Amount)
+	require.Equal(t, int64(30), result.Sweep.ToAccount.Balance)
+	require.Zero(t, result.Sweep.FromEntry.Balance)
+
+	// no money moves into a closed account
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        1,
+	})
+	require.ErrorIs(t, err, ErrAccountNotActive)
+
+	// nor is it adjusted
+	_, err = testStore.AdjustAccountBalanceTx(context.Background(), AdjustAccountBalanceTxParams{
+		AccountID: account1.ID,
+		Amount:    1,
+		Reason:    "goodwill credit",
+		Audit:     AuditMetadata{Actor: account2.Owner},
+	})
+	require.ErrorIs(t, err, ErrAccountNotActive)
+}
+
+func TestCloseAccountTxFrozenSweep(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 10)
+	account2 := createRandomAccountWithBalance(t, 20)
+
+	_, err := testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID:  account1.ID,
+		FromStatus: util.AccountStatusActive,
+		ToStatus:   util.AccountStatusFrozen,
+		Reason:     "suspected card fraud",
+		Audit:      AuditMetadata{Actor: account2.Owner},
+	})
+	require.NoError(t, err)
+
+	// money cannot be swept out of a frozen account
+	_, err = testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:        account1.ID,
+		SweepToAccountID: account2.ID,
+		ExchangeRate:     IdentityExchangeRate(),
+		Reason:           "moving to another bank",
+		Audit:            AuditMetadata{Actor: account1.Owner},
+	})
+	require.ErrorIs(t, err, ErrAccountNotActive)
+
+	updatedAccount, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.AccountStatusFrozen, updatedAccount.Status)
+	require.Equal(t, account1.Balance, updatedAccount.Balance)
+}
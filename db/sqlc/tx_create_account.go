@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+type CreateAccountTxParams struct {
+	CreateAccountParams
+}
+
+type CreateAccountTxResult struct {
+	Account Account
+}
+
+// CreateAccountTx creates an account and emits an account.created event
+func (store *SQLStore) CreateAccountTx(ctx context.Context, arg CreateAccountTxParams) (CreateAccountTxResult, error) {
+	var result CreateAccountTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Account, err = q.CreateAccount(ctx, arg.CreateAccountParams)
+		if err != nil {
+			return err
+		}
+
+		return store.recordDomainEvent(ctx, q, util.EventAccountCreated, []string{result.Account.Owner}, result.Account)
+	})
+
+	return result, err
+}
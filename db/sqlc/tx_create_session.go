@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+type CreateSessionTxParams struct {
+	CreateSessionParams
+	Audit AuditMetadata
+}
+
+type CreateSessionTxResult struct {
+	Session Session
+}
+
+// CreateSessionTx starts the session of a user who just logged in, and records the login in the audit log
+func (store *SQLStore) CreateSessionTx(ctx context.Context, arg CreateSessionTxParams) (CreateSessionTxResult, error) {
+	var result CreateSessionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Session, err = q.CreateSession(ctx, arg.CreateSessionParams)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, arg.Audit, util.AuditActionUserLogin, util.AuditTargetUser, result.Session.Username, nil, result.Session)
+	})
+
+	return result, err
+}
@@ -1,10 +1,16 @@
 package db
 
-import "context"
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
 
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error
+	// AfterCreate returns the worker tasks to write to the outbox along with the user
+	AfterCreate func(user User) ([]CreateOutboxTaskParams, error)
+	Audit       AuditMetadata
 }
 
 type CreateUserTxResult struct {
@@ -22,7 +28,17 @@ func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams)
 			return err
 		}
 
-		return arg.AfterCreate(result.User)
+		err = recordAuditEvent(ctx, q, arg.Audit, util.AuditActionUserCreate, util.AuditTargetUser, result.User.Username, nil, result.User)
+		if err != nil {
+			return err
+		}
+
+		tasks, err := arg.AfterCreate(result.User)
+		if err != nil {
+			return err
+		}
+
+		return createOutboxTasks(ctx, q, tasks)
 	})
 
 	return result, err
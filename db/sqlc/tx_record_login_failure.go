@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+type RecordLoginFailureTxParams struct {
+	// Username is the one the login was attempted with, which may not exist
+	Username string
+	// Locked tells that this failure locked the username out
+	Locked bool
+	Audit  AuditMetadata
+}
+
+// RecordLoginFailureTx records a failed login in the audit log, along with the lockout it caused if any
+func (store *SQLStore) RecordLoginFailureTx(ctx context.Context, arg RecordLoginFailureTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		err := recordAuditEvent(ctx, q, arg.Audit, util.AuditActionUserLoginFailed, util.AuditTargetUser, arg.Username, nil, nil)
+		if err != nil || !arg.Locked {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, arg.Audit, util.AuditActionUserLockout, util.AuditTargetUser, arg.Username, nil, nil)
+	})
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+type ResetPasswordTxParams struct {
+	ResetId        int64
+	SecretCode     string
+	HashedPassword string
+	Audit          AuditMetadata
+}
+
+type ResetPasswordTxResult struct {
+	User          User
+	PasswordReset PasswordReset
+}
+
+// ResetPasswordTx uses up a password reset code and sets the new password.
+// Every session of the user is blocked, as well as any other outstanding reset code.
+// The user is recorded as the actor in the audit log, since only they could have received the code.
+func (store *SQLStore) ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error) {
+	var result ResetPasswordTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.PasswordReset, err = q.UpdatePasswordReset(ctx, UpdatePasswordResetParams{
+			ID:         arg.ResetId,
+			SecretCode: arg.SecretCode,
+		})
+		if err != nil {
+			return err
+		}
+
+		user, err := q.GetUser(ctx, result.PasswordReset.Username)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.UpdateUser(ctx, UpdateUserParams{
+			Username: result.PasswordReset.Username,
+			HashedPassword: pgtype.Text{
+				String: arg.HashedPassword,
+				Valid:  true,
+			},
+			PasswordChangedAt: pgtype.Timestamptz{
+				Time:  time.Now(),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.InvalidateUserPasswordResets(ctx, result.User.Username)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.BlockUserSessions(ctx, result.User.Username)
+		if err != nil {
+			return err
+		}
+
+		audit := arg.Audit
+		audit.Actor = user.Username
+		return recordAuditEvent(ctx, q, audit, util.AuditActionUserResetPassword, util.AuditTargetUser, user.Username, user, result.User)
+	})
+
+	return result, err
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPasswordReset(t *testing.T, user User) PasswordReset {
+	passwordReset, err := testStore.CreatePasswordReset(context.Background(), CreatePasswordResetParams{
+		Username:   user.Username,
+		SecretCode: util.RandomString(32),
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.Username, passwordReset.Username)
+	require.False(t, passwordReset.IsUsed)
+	require.True(t, passwordReset.ExpiredAt.After(passwordReset.CreatedAt))
+
+	return passwordReset
+}
+
+func TestResetPasswordTx(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+	reset1 := createRandomPasswordReset(t, user)
+	reset2 := createRandomPasswordReset(t, user)
+
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	// a wrong secret code does not change anything
+	_, err = testStore.ResetPasswordTx(context.Background(), ResetPasswordTxParams{
+		ResetId:        reset1.ID,
+		SecretCode:     util.RandomString(32),
+		HashedPassword: hashedPassword,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	result, err := testStore.ResetPasswordTx(context.Background(), ResetPasswordTxParams{
+		ResetId:        reset1.ID,
+		SecretCode:     reset1.SecretCode,
+		HashedPassword: hashedPassword,
+	})
+	require.NoError(t, err)
+	require.True(t, result.PasswordReset.IsUsed)
+	require.Equal(t, hashedPassword, result.User.HashedPassword)
+	require.True(t, result.User.PasswordChangedAt.After(user.PasswordChangedAt))
+
+	session, err = testStore.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsBlocked)
+
+	// the other outstanding code cannot be used anymore
+	_, err = testStore.ResetPasswordTx(context.Background(), ResetPasswordTxParams{
+		ResetId:        reset2.ID,
+		SecretCode:     reset2.SecretCode,
+		HashedPassword: hashedPassword,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestGetUserByEmail(t *testing.T) {
+	user1 := createRandomUser(t)
+
+	user2, err := testStore.GetUserByEmail(context.Background(), user1.Email)
+	require.NoError(t, err)
+	require.Equal(t, user1.Username, user2.Username)
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// RotateSessionTxParams contains the input parameters of the session rotation transaction
+type RotateSessionTxParams struct {
+	SessionID uuid.UUID
+	// NewSession is the session of the new refresh token. Its family and parent are set by the transaction.
+	NewSession CreateSessionParams
+}
+
+// RotateSessionTxResult is the result of the session rotation transaction
+type RotateSessionTxResult struct {
+	Session Session
+}
+
+// RotateSessionTx replaces a session with a new one chained to it, so each refresh token can be used only once.
+// If the session was already rotated, its refresh token is being reused: the whole session family is blocked
+// and ErrRefreshTokenReused is returned. It returns ErrSessionBlocked if the session was blocked.
+func (store *SQLStore) RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (RotateSessionTxResult, error) {
+	var result RotateSessionTxResult
+	var reused bool
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		session, err := q.GetSessionForUpdate(ctx, arg.SessionID)
+		if err != nil {
+			return err
+		}
+
+		if session.IsBlocked {
+			return ErrSessionBlocked
+		}
+
+		// the block must be committed, so the error is only returned after the transaction
+		if session.IsRotated {
+			reused = true
+			_, err = q.BlockSessionFamily(ctx, session.FamilyID)
+			return err
+		}
+
+		_, err = q.RotateSession(ctx, session.ID)
+		if err != nil {
+			return err
+		}
+
+		newSession := arg.NewSession
+		newSession.FamilyID = pgtype.UUID{Bytes: session.FamilyID, Valid: true}
+		newSession.ParentID = pgtype.UUID{Bytes: session.ID, Valid: true}
+
+		result.Session, err = q.CreateSession(ctx, newSession)
+		return err
+	})
+	if err == nil && reused {
+		err = ErrRefreshTokenReused
+	}
+
+	return result, err
+}
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomRotatedSessionParams(username string) CreateSessionParams {
+	return CreateSessionParams{
+		ID:           uuid.New(),
+		Username:     username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    util.RandomString(10),
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+}
+
+func TestRotateSessionTx(t *testing.T) {
+	user := createRandomUser(t)
+	session1 := createRandomSession(t, user)
+	require.Equal(t, session1.ID, session1.FamilyID)
+
+	result, err := testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		SessionID:  session1.ID,
+		NewSession: randomRotatedSessionParams(user.Username),
+	})
+	require.NoError(t, err)
+
+	session2 := result.Session
+	require.Equal(t, session1.FamilyID, session2.FamilyID)
+	require.True(t, session2.ParentID.Valid)
+	require.Equal(t, session1.ID, uuid.UUID(session2.ParentID.Bytes))
+	require.False(t, session2.IsRotated)
+
+	rotatedSession, err := testStore.GetSession(context.Background(), session1.ID)
+	require.NoError(t, err)
+	require.True(t, rotatedSession.IsRotated)
+	require.False(t, rotatedSession.IsBlocked)
+
+	// presenting the old refresh token again blocks the whole family
+	_, err = testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		SessionID:  session1.ID,
+		NewSession: randomRotatedSessionParams(user.Username),
+	})
+	require.ErrorIs(t, err, ErrRefreshTokenReused)
+
+	for _, id := range []uuid.UUID{session1.ID, session2.ID} {
+		session, err := testStore.GetSession(context.Background(), id)
+		require.NoError(t, err)
+		require.True(t, session.IsBlocked)
+	}
+
+	_, err = testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		SessionID:  session2.ID,
+		NewSession: randomRotatedSessionParams(user.Username),
+	})
+	require.ErrorIs(t, err, ErrSessionBlocked)
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+type SetExchangeRateTxParams struct {
+	FromCurrency string
+	ToCurrency   string
+	Rate         pgtype.Numeric
+	Audit        AuditMetadata
+}
+
+type SetExchangeRateTxResult struct {
+	ExchangeRate ExchangeRate
+}
+
+// SetExchangeRateTx sets the rate of a currency pair, which is created if it is not known yet
+func (store *SQLStore) SetExchangeRateTx(ctx context.Context, arg SetExchangeRateTxParams) (SetExchangeRateTxResult, error) {
+	var result SetExchangeRateTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// a new currency pair has no state before the change
+		var before any
+		rate, err := q.GetExchangeRate(ctx, GetExchangeRateParams{
+			FromCurrency: arg.FromCurrency,
+			ToCurrency:   arg.ToCurrency,
+		})
+		if err == nil {
+			before = rate
+		} else if !errors.Is(err, ErrRecordNotFound) {
+			return err
+		}
+
+		result.ExchangeRate, err = q.UpsertExchangeRate(ctx, UpsertExchangeRateParams{
+			FromCurrency: arg.FromCurrency,
+			ToCurrency:   arg.ToCurrency,
+			Rate:         arg.Rate,
+		})
+		if err != nil {
+			return err
+		}
+
+		targetID := arg.FromCurrency + "/" + arg.ToCurrency
+		return recordAuditEvent(ctx, q, arg.Audit, util.AuditActionExchangeRateSet, util.AuditTargetExchangeRate, targetID, before, result.ExchangeRate)
+	})
+
+	return result, err
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetExchangeRateTx(t *testing.T) {
+	banker := createRandomUser(t)
+	fromCurrency := util.RandomString(3)
+	toCurrency := util.RandomString(3)
+
+	arg := SetExchangeRateTxParams{
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Rate:         mustParseExchangeRate(t, "0.92"),
+		Audit:        AuditMetadata{Actor: banker.Username},
+	}
+
+	result1, err := testStore.SetExchangeRateTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, fromCurrency, result1.ExchangeRate.FromCurrency)
+	require.Equal(t, toCurrency, result1.ExchangeRate.ToCurrency)
+	require.Equal(t, "0.92", FormatExchangeRate(result1.ExchangeRate.Rate))
+
+	arg.Rate = mustParseExchangeRate(t, "0.9215")
+	result2, err := testStore.SetExchangeRateTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, "0.9215", FormatExchangeRate(result2.ExchangeRate.Rate))
+
+	events, err := testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		TargetType: pgtype.Text{String: util.AuditTargetExchangeRate, Valid: true},
+		TargetID:   pgtype.Text{String: fromCurrency + "/" + toCurrency, Valid: true},
+		EndTime:    time.Now().Add(time.Minute),
+		Limit:      10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	for _, event := range events {
+		require.Equal(t, util.AuditActionExchangeRateSet, event.Action)
+		require.Equal(t, banker.Username, event.Actor)
+	}
+
+	// the first event created the currency pair
+	require.Nil(t, events[0].Before)
+	require.NotNil(t, events[1].Before)
+}
@@ -1,12 +1,37 @@
 package db
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
+)
 
 // TransferTxParams contains the input parameters of the transfer transaction
 type TransferTxParams struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
 	Amount        int64 `json:"amount"`
+	// IdempotencyKey is optional. A retried transfer with the same key
+	// returns the result of the original one instead of moving money again.
+	IdempotencyKey string        `json:"idempotency_key"`
+	Audit          AuditMetadata `json:"-"`
+}
+
+// CrossCurrencyTransferTxParams contains the input parameters of the cross-currency transfer transaction
+type CrossCurrencyTransferTxParams struct {
+	FromAccountID int64 `json:"from_account_id"`
+	ToAccountID   int64 `json:"to_account_id"`
+	// Amount is in the sender's currency.
+	Amount int64 `json:"amount"`
+	// ExchangeRate is the number of units of the receiver's currency for one unit of the sender's.
+	ExchangeRate   pgtype.Numeric `json:"exchange_rate"`
+	IdempotencyKey string         `json:"idempotency_key"`
+	Audit          AuditMetadata  `json:"-"`
 }
 
 // TransferTxResult is the result of the transfer transaction
@@ -19,50 +44,210 @@ type TransferTxResult struct {
 }
 
 // TransferTx performs a money transfer from one account to the other.
-// It creates the transfer, add account entries, and update accounts' balance within a database transaction
+// It creates the transfer, add account entries, and update accounts' balance within a database transaction.
+// It returns ErrInsufficientFunds if the transfer would take the sender below its overdraft limit,
+// and ErrAccountNotActive if either account is frozen or closed.
+// If an idempotency key is given and was already used for this sender, the original result is returned.
+// The transfer is recorded in the audit log and emitted as a transfer.created event, but a replayed one is not.
+// Listeners of AccountActivityChannel are notified about both entries when the transaction commits.
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
+	return store.transferTx(ctx, arg, IdentityExchangeRate())
+}
+
+// CrossCurrencyTransferTx performs a money transfer between accounts of different currencies.
+// The sender is debited arg.Amount and the receiver is credited arg.Amount converted with arg.ExchangeRate
+// and rounded half to even to a whole unit, both of which are recorded on the transfer.
+// It returns ErrInvalidConvertedAmount if the converted amount is not positive.
+func (store *SQLStore) CrossCurrencyTransferTx(ctx context.Context, arg CrossCurrencyTransferTxParams) (TransferTxResult, error) {
+	return store.transferTx(ctx, TransferTxParams{
+		FromAccountID:  arg.FromAccountID,
+		ToAccountID:    arg.ToAccountID,
+		Amount:         arg.Amount,
+		IdempotencyKey: arg.IdempotencyKey,
+		Audit:          arg.Audit,
+	}, arg.ExchangeRate)
+}
+
+func (store *SQLStore) transferTx(ctx context.Context, arg TransferTxParams, exchangeRate pgtype.Numeric) (TransferTxResult, error) {
 	var result TransferTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
+		result, err = store.transfer(ctx, q, arg, exchangeRate)
+		return err
+	})
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+	return result, err
+}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+// transfer moves money between two accounts within the transaction of q.
+// It returns ErrAccountNotActive if either account is frozen or closed.
+func (store *SQLStore) transfer(ctx context.Context, q *Queries, arg TransferTxParams, exchangeRate pgtype.Numeric) (TransferTxResult, error) {
+	var result TransferTxResult
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
-		if err != nil {
-			return err
+	toAmount, err := convertAmount(arg.Amount, exchangeRate)
+	if err != nil {
+		return result, err
+	}
+	if toAmount <= 0 {
+		return result, ErrInvalidConvertedAmount
+	}
+
+	var fromAccount, toAccount Account
+
+	if arg.FromAccountID < arg.ToAccountID {
+		fromAccount, toAccount, err = lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID)
+	} else {
+		toAccount, fromAccount, err = lockAccounts(ctx, q, arg.ToAccountID, arg.FromAccountID)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	// the sender's row is locked now, so a concurrent retry with the same key
+	// waits here until we commit and then sees our idempotency key
+	if arg.IdempotencyKey != "" {
+		replayed, err := replayTransfer(ctx, q, arg)
+		if err == nil {
+			return replayed, nil
 		}
+		if !errors.Is(err, ErrRecordNotFound) {
+			return result, err
+		}
+	}
 
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+	// both rows are locked, so their status cannot change until we commit
+	for _, account := range []Account{fromAccount, toAccount} {
+		if account.Status != util.AccountStatusActive {
+			return result, fmt.Errorf("account [%d] is %s: %w", account.ID, account.Status, ErrAccountNotActive)
 		}
+	}
 
-		return err
+	if fromAccount.Balance+fromAccount.OverdraftLimit < arg.Amount {
+		return result, ErrInsufficientFunds
+	}
+
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		ToAmount:      toAmount,
+		ExchangeRate:  exchangeRate,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if arg.FromAccountID < arg.ToAccountID {
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, toAmount)
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, toAmount, arg.FromAccountID, -arg.Amount)
+	}
+	if ErrorCode(err) == CheckViolation {
+		return result, ErrInsufficientFunds
+	}
+	if err != nil {
+		return result, err
+	}
+
+	// entries are written after the balances so each one records the balance it left behind
+	result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.FromAccountID,
+		Amount:    -arg.Amount,
+		Balance:   result.FromAccount.Balance,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.ToAccountID,
+		Amount:    toAmount,
+		Balance:   result.ToAccount.Balance,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	err = recordAccountActivity(ctx, q, result.FromAccount, result.FromEntry)
+	if err != nil {
+		return result, err
+	}
+
+	err = recordAccountActivity(ctx, q, result.ToAccount, result.ToEntry)
+	if err != nil {
+		return result, err
+	}
+
+	transferID := strconv.FormatInt(result.Transfer.ID, 10)
+	err = recordAuditEvent(ctx, q, arg.Audit, util.AuditActionTransferCreate, util.AuditTargetTransfer, transferID, nil, result.Transfer)
+	if err != nil {
+		return result, err
+	}
+
+	err = store.recordDomainEvent(ctx, q, util.EventTransferCreated, []string{fromAccount.Owner, toAccount.Owner}, result.Transfer)
+	if err != nil || arg.IdempotencyKey == "" {
+		return result, err
+	}
+
+	return result, saveIdempotencyKey(ctx, q, arg, result)
+}
+
+// replayTransfer returns the stored result of a transfer made earlier with the same idempotency key.
+// It returns ErrIdempotencyKeyReused if that transfer had different parameters.
+func replayTransfer(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	key, err := q.GetIdempotencyKey(ctx, GetIdempotencyKeyParams{
+		FromAccountID:  arg.FromAccountID,
+		IdempotencyKey: arg.IdempotencyKey,
 	})
+	if err != nil {
+		return result, err
+	}
 
+	if key.ToAccountID != arg.ToAccountID || key.Amount != arg.Amount {
+		return result, ErrIdempotencyKeyReused
+	}
+
+	err = json.Unmarshal(key.Result, &result)
 	return result, err
 }
 
+func saveIdempotencyKey(ctx context.Context, q *Queries, arg TransferTxParams, result TransferTxResult) error {
+	jsonResult, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateIdempotencyKey(ctx, CreateIdempotencyKeyParams{
+		FromAccountID:  arg.FromAccountID,
+		IdempotencyKey: arg.IdempotencyKey,
+		ToAccountID:    arg.ToAccountID,
+		Amount:         arg.Amount,
+		TransferID:     result.Transfer.ID,
+		Result:         jsonResult,
+	})
+	return err
+}
+
+// lockAccounts locks both accounts for update. Callers must pass the smaller ID first
+// so that concurrent transfers always acquire the locks in the same order.
+func lockAccounts(
+	ctx context.Context,
+	q *Queries,
+	accountID1 int64,
+	accountID2 int64,
+) (account1 Account, account2 Account, err error) {
+	account1, err = q.GetAccountForUpdate(ctx, accountID1)
+	if err != nil {
+		return
+	}
+
+	account2, err = q.GetAccountForUpdate(ctx, accountID2)
+	return
+}
+
 func addMoney(
 	ctx context.Context,
 	q *Queries,
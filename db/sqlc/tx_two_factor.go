@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+type EnableUserTotpTxParams struct {
+	EnableUserTotpParams
+	Audit AuditMetadata
+}
+
+type EnableUserTotpTxResult struct {
+	User User
+}
+
+// EnableUserTotpTx turns on two-factor authentication for the user, and records it in the audit log
+func (store *SQLStore) EnableUserTotpTx(ctx context.Context, arg EnableUserTotpTxParams) (EnableUserTotpTxResult, error) {
+	var result EnableUserTotpTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		user, err := q.GetUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.EnableUserTotp(ctx, arg.EnableUserTotpParams)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, arg.Audit, util.AuditActionUserEnableTwoFactor, util.AuditTargetUser, user.Username, user, result.User)
+	})
+
+	return result, err
+}
+
+type DisableUserTotpTxParams struct {
+	Username string
+	Audit    AuditMetadata
+}
+
+type DisableUserTotpTxResult struct {
+	User User
+}
+
+// DisableUserTotpTx turns off two-factor authentication for the user, and records it in the audit log
+func (store *SQLStore) DisableUserTotpTx(ctx context.Context, arg DisableUserTotpTxParams) (DisableUserTotpTxResult, error) {
+	var result DisableUserTotpTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		user, err := q.GetUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.DisableUserTotp(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, arg.Audit, util.AuditActionUserDisableTwoFactor, util.AuditTargetUser, user.Username, user, result.User)
+	})
+
+	return result, err
+}
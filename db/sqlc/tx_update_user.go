@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+type UpdateUserTxParams struct {
+	UpdateUserParams
+	// AfterUpdate returns the worker tasks to write to the outbox along with the change
+	AfterUpdate func(user User) ([]CreateOutboxTaskParams, error)
+	Audit       AuditMetadata
+}
+
+type UpdateUserTxResult struct {
+	User User
+}
+
+// UpdateUserTx updates the user and writes the tasks of AfterUpdate to the outbox.
+// Changing the password blocks every session of the user, like ResetPasswordTx does.
+func (store *SQLStore) UpdateUserTx(ctx context.Context, arg UpdateUserTxParams) (UpdateUserTxResult, error) {
+	var result UpdateUserTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		user, err := q.GetUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.UpdateUser(ctx, arg.UpdateUserParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.HashedPassword.Valid {
+			_, err = q.BlockUserSessions(ctx, result.User.Username)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = recordAuditEvent(ctx, q, arg.Audit, util.AuditActionUserUpdate, util.AuditTargetUser, user.Username, user, result.User)
+		if err != nil {
+			return err
+		}
+
+		tasks, err := arg.AfterUpdate(result.User)
+		if err != nil {
+			return err
+		}
+
+		return createOutboxTasks(ctx, q, tasks)
+	})
+
+	return result, err
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+type UpdateUserRoleTxParams struct {
+	Username string
+	Role     string
+	Audit    AuditMetadata
+}
+
+type UpdateUserRoleTxResult struct {
+	User User
+}
+
+// UpdateUserRoleTx assigns a new role to the user.
+// Every session of the user is blocked, since their tokens still carry the old role.
+func (store *SQLStore) UpdateUserRoleTx(ctx context.Context, arg UpdateUserRoleTxParams) (UpdateUserRoleTxResult, error) {
+	var result UpdateUserRoleTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		user, err := q.GetUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.UpdateUserRole(ctx, UpdateUserRoleParams{
+			Username: arg.Username,
+			Role:     arg.Role,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.BlockUserSessions(ctx, result.User.Username)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, arg.Audit, util.AuditActionUserAssignRole, util.AuditTargetUser, user.Username, user, result.User)
+	})
+
+	return result, err
+}
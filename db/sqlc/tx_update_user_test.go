@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateUserTxBlocksSessionsOnPasswordChange(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	noTasks := func(user User) ([]CreateOutboxTaskParams, error) { return nil, nil }
+
+	// other changes keep the sessions
+	_, err := testStore.UpdateUserTx(context.Background(), UpdateUserTxParams{
+		UpdateUserParams: UpdateUserParams{
+			Username: user.Username,
+			FullName: pgtype.Text{String: util.RandomOwner(), Valid: true},
+		},
+		AfterUpdate: noTasks,
+	})
+	require.NoError(t, err)
+
+	session, err = testStore.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.False(t, session.IsBlocked)
+
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	_, err = testStore.UpdateUserTx(context.Background(), UpdateUserTxParams{
+		UpdateUserParams: UpdateUserParams{
+			Username:          user.Username,
+			HashedPassword:    pgtype.Text{String: hashedPassword, Valid: true},
+			PasswordChangedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		},
+		AfterUpdate: noTasks,
+	})
+	require.NoError(t, err)
+
+	session, err = testStore.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsBlocked)
+}
@@ -2,13 +2,16 @@ package db
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
 )
 
 type VerifyEmailTxParams struct {
 	EmailId    int64
 	SecretCode string
+	Audit      AuditMetadata
 }
 
 type VerifyEmailTxResult struct {
@@ -16,6 +19,9 @@ type VerifyEmailTxResult struct {
 	VerifyEmail VerifyEmail
 }
 
+// VerifyEmailTx uses up an email verification code.
+// If the code was sent to the user's pending email, that address replaces the current one.
+// The user is recorded as the actor in the audit log, since only they could have received the code.
 func (store *SQLStore) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error) {
 	var result VerifyEmailTxResult
 
@@ -30,14 +36,43 @@ func (store *SQLStore) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParam
 			return err
 		}
 
-		result.User, err = q.UpdateUser(ctx, UpdateUserParams{
-			Username: result.VerifyEmail.Username,
-			IsEmailVerified: pgtype.Bool{
-				Bool:  true,
-				Valid: true,
-			},
+		user, err := q.GetUser(ctx, result.VerifyEmail.Username)
+		if err != nil {
+			return err
+		}
+
+		if user.Email != result.VerifyEmail.Email {
+			result.User, err = q.ConfirmUserPendingEmail(ctx, ConfirmUserPendingEmailParams{
+				Username:     user.Username,
+				PendingEmail: result.VerifyEmail.Email,
+			})
+			if errors.Is(err, ErrRecordNotFound) {
+				return ErrPendingEmailSuperseded
+			}
+		} else {
+			result.User, err = q.UpdateUser(ctx, UpdateUserParams{
+				Username: result.VerifyEmail.Username,
+				IsEmailVerified: pgtype.Bool{
+					Bool:  true,
+					Valid: true,
+				},
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		audit := arg.Audit
+		audit.Actor = user.Username
+		err = recordAuditEvent(ctx, q, audit, util.AuditActionUserVerifyEmail, util.AuditTargetUser, user.Username, user, result.User)
+		if err != nil {
+			return err
+		}
+
+		return store.recordDomainEvent(ctx, q, util.EventUserEmailVerified, []string{user.Username}, EmailVerifiedEventData{
+			Username: result.User.Username,
+			Email:    result.User.Email,
 		})
-		return err
 	})
 
 	return result, err
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomVerifyEmail(t *testing.T, user User, email string) VerifyEmail {
+	verifyEmail, err := testStore.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+		Username:   user.Username,
+		Email:      email,
+		SecretCode: util.RandomString(32),
+	})
+	require.NoError(t, err)
+
+	return verifyEmail
+}
+
+func TestVerifyEmailTx(t *testing.T) {
+	user := createRandomUser(t)
+	verifyEmail := createRandomVerifyEmail(t, user, user.Email)
+
+	result, err := testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailId:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.NoError(t, err)
+	require.True(t, result.VerifyEmail.IsUsed)
+	require.True(t, result.User.IsEmailVerified)
+	require.Equal(t, user.Email, result.User.Email)
+}
+
+func TestVerifyEmailTxPendingEmail(t *testing.T) {
+	user := createRandomUser(t)
+	newEmail := util.RandomEmail()
+
+	user, err := testStore.UpdateUser(context.Background(), UpdateUserParams{
+		Username:     user.Username,
+		PendingEmail: pgtype.Text{String: newEmail, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, newEmail, user.PendingEmail.String)
+	require.NotEqual(t, newEmail, user.Email)
+
+	// asking for the current email again drops the pending one
+	sameUser, err := testStore.UpdateUser(context.Background(), UpdateUserParams{
+		Username:     user.Username,
+		PendingEmail: pgtype.Text{String: user.Email, Valid: true},
+	})
+	require.NoError(t, err)
+	require.False(t, sameUser.PendingEmail.Valid)
+
+	user, err = testStore.UpdateUser(context.Background(), UpdateUserParams{
+		Username:     user.Username,
+		PendingEmail: pgtype.Text{String: newEmail, Valid: true},
+	})
+	require.NoError(t, err)
+
+	verifyEmail := createRandomVerifyEmail(t, user, newEmail)
+	result, err := testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailId:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newEmail, result.User.Email)
+	require.False(t, result.User.PendingEmail.Valid)
+	require.True(t, result.User.IsEmailVerified)
+}
+
+func TestVerifyEmailTxStalePendingEmail(t *testing.T) {
+	user := createRandomUser(t)
+
+	user, err := testStore.UpdateUser(context.Background(), UpdateUserParams{
+		Username:     user.Username,
+		PendingEmail: pgtype.Text{String: util.RandomEmail(), Valid: true},
+	})
+	require.NoError(t, err)
+	staleVerifyEmail := createRandomVerifyEmail(t, user, user.PendingEmail.String)
+
+	_, err = testStore.UpdateUser(context.Background(), UpdateUserParams{
+		Username:     user.Username,
+		PendingEmail: pgtype.Text{String: util.RandomEmail(), Valid: true},
+	})
+	require.NoError(t, err)
+
+	// a code sent to an address the user moved away from cannot be used
+	_, err = testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailId:    staleVerifyEmail.ID,
+		SecretCode: staleVerifyEmail.SecretCode,
+	})
+	require.ErrorIs(t, err, ErrPendingEmailSuperseded)
+}
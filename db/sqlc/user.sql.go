@@ -11,6 +11,44 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const confirmUserPendingEmail = `-- name: ConfirmUserPendingEmail :one
+UPDATE users
+SET
+  email = pending_email,
+  pending_email = NULL,
+  is_email_verified = TRUE
+WHERE
+  username = $1
+  AND pending_email = $2::varchar
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, totp_secret, is_totp_enabled, totp_last_step, recovery_codes, pending_email
+`
+
+type ConfirmUserPendingEmailParams struct {
+	Username     string `json:"username"`
+	PendingEmail string `json:"pending_email"`
+}
+
+func (q *Queries) ConfirmUserPendingEmail(ctx context.Context, arg ConfirmUserPendingEmailParams) (User, error) {
+	row := q.db.QueryRow(ctx, confirmUserPendingEmail, arg.Username, arg.PendingEmail)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
+		&i.RecoveryCodes,
+		&i.PendingEmail,
+	)
+	return i, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
   username,
@@ -19,7 +57,7 @@ INSERT INTO users (
   email
 ) VALUES (
   $1, $2, $3, $4
-) RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role
+) RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, totp_secret, is_totp_enabled, totp_last_step, recovery_codes, pending_email
 `
 
 type CreateUserParams struct {
@@ -46,12 +84,89 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.CreatedAt,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
+		&i.RecoveryCodes,
+		&i.PendingEmail,
+	)
+	return i, err
+}
+
+const disableUserTotp = `-- name: DisableUserTotp :one
+UPDATE users
+SET
+  totp_secret = NULL,
+  is_totp_enabled = false,
+  totp_last_step = 0,
+  recovery_codes = '{}'
+WHERE
+  username = $1
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, totp_secret, is_totp_enabled, totp_last_step, recovery_codes, pending_email
+`
+
+func (q *Queries) DisableUserTotp(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, disableUserTotp, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
+		&i.RecoveryCodes,
+		&i.PendingEmail,
+	)
+	return i, err
+}
+
+const enableUserTotp = `-- name: EnableUserTotp :one
+UPDATE users
+SET
+  is_totp_enabled = true,
+  totp_last_step = $1,
+  recovery_codes = $2::varchar[]
+WHERE
+  username = $3
+  AND totp_secret IS NOT NULL
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, totp_secret, is_totp_enabled, totp_last_step, recovery_codes, pending_email
+`
+
+type EnableUserTotpParams struct {
+	TotpLastStep  int64    `json:"totp_last_step"`
+	RecoveryCodes []string `json:"recovery_codes"`
+	Username      string   `json:"username"`
+}
+
+func (q *Queries) EnableUserTotp(ctx context.Context, arg EnableUserTotpParams) (User, error) {
+	row := q.db.QueryRow(ctx, enableUserTotp, arg.TotpLastStep, arg.RecoveryCodes, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
+		&i.RecoveryCodes,
+		&i.PendingEmail,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role FROM users
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, totp_secret, is_totp_enabled, totp_last_step, recovery_codes, pending_email FROM users
 WHERE username = $1 LIMIT 1
 `
 
@@ -67,6 +182,146 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.CreatedAt,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
+		&i.RecoveryCodes,
+		&i.PendingEmail,
+	)
+	return i, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, totp_secret, is_totp_enabled, totp_last_step, recovery_codes, pending_email FROM users
+WHERE email = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
+		&i.RecoveryCodes,
+		&i.PendingEmail,
+	)
+	return i, err
+}
+
+const rehashUserPassword = `-- name: RehashUserPassword :execrows
+UPDATE users
+SET
+  hashed_password = $1
+WHERE
+  username = $2
+  AND hashed_password = $3
+`
+
+type RehashUserPasswordParams struct {
+	NewHashedPassword string `json:"new_hashed_password"`
+	Username          string `json:"username"`
+	OldHashedPassword string `json:"old_hashed_password"`
+}
+
+func (q *Queries) RehashUserPassword(ctx context.Context, arg RehashUserPasswordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, rehashUserPassword, arg.NewHashedPassword, arg.Username, arg.OldHashedPassword)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, totp_secret, is_totp_enabled, totp_last_step, recovery_codes, pending_email FROM users
+WHERE
+    (username ILIKE $1 OR email ILIKE $1 OR full_name ILIKE $1) AND
+    username > $2
+ORDER BY username
+LIMIT $3
+`
+
+type SearchUsersParams struct {
+	Pattern       string `json:"pattern"`
+	AfterUsername string `json:"after_username"`
+	Limit         int32  `json:"limit"`
+}
+
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsers, arg.Pattern, arg.AfterUsername, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.IsEmailVerified,
+			&i.Role,
+			&i.TotpSecret,
+			&i.IsTotpEnabled,
+			&i.TotpLastStep,
+			&i.RecoveryCodes,
+			&i.PendingEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setUserTotpSecret = `-- name: SetUserTotpSecret :one
+UPDATE users
+SET
+  totp_secret = $1::varchar,
+  recovery_codes = '{}'
+WHERE
+  username = $2
+  AND is_totp_enabled = false
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, totp_secret, is_totp_enabled, totp_last_step, recovery_codes, pending_email
+`
+
+type SetUserTotpSecretParams struct {
+	TotpSecret string `json:"totp_secret"`
+	Username   string `json:"username"`
+}
+
+func (q *Queries) SetUserTotpSecret(ctx context.Context, arg SetUserTotpSecretParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserTotpSecret, arg.TotpSecret, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
+		&i.RecoveryCodes,
+		&i.PendingEmail,
 	)
 	return i, err
 }
@@ -78,10 +333,11 @@ SET
   password_changed_at = COALESCE($2, password_changed_at),
   full_name = COALESCE($3, full_name),
   email = COALESCE($4, email),
-  is_email_verified = COALESCE($5, is_email_verified)
+  pending_email = NULLIF(COALESCE($5, pending_email), COALESCE($4, email)),
+  is_email_verified = COALESCE($6, is_email_verified)
 WHERE
-  username = $6
-RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role
+  username = $7
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, totp_secret, is_totp_enabled, totp_last_step, recovery_codes, pending_email
 `
 
 type UpdateUserParams struct {
@@ -89,6 +345,7 @@ type UpdateUserParams struct {
 	PasswordChangedAt pgtype.Timestamptz `json:"password_changed_at"`
 	FullName          pgtype.Text        `json:"full_name"`
 	Email             pgtype.Text        `json:"email"`
+	PendingEmail      pgtype.Text        `json:"pending_email"`
 	IsEmailVerified   pgtype.Bool        `json:"is_email_verified"`
 	Username          string             `json:"username"`
 }
@@ -99,6 +356,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		arg.PasswordChangedAt,
 		arg.FullName,
 		arg.Email,
+		arg.PendingEmail,
 		arg.IsEmailVerified,
 		arg.Username,
 	)
@@ -112,6 +370,55 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.CreatedAt,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
+		&i.RecoveryCodes,
+		&i.PendingEmail,
 	)
 	return i, err
 }
+
+const useUserRecoveryCode = `-- name: UseUserRecoveryCode :execrows
+UPDATE users
+SET
+  recovery_codes = array_remove(recovery_codes, $1::varchar)
+WHERE
+  username = $2
+  AND $1::varchar = ANY(recovery_codes)
+`
+
+type UseUserRecoveryCodeParams struct {
+	RecoveryCode string `json:"recovery_code"`
+	Username     string `json:"username"`
+}
+
+func (q *Queries) UseUserRecoveryCode(ctx context.Context, arg UseUserRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useUserRecoveryCode, arg.RecoveryCode, arg.Username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useUserTotpStep = `-- name: UseUserTotpStep :execrows
+UPDATE users
+SET
+  totp_last_step = $1
+WHERE
+  username = $2
+  AND totp_last_step < $1
+`
+
+type UseUserTotpStepParams struct {
+	TotpLastStep int64  `json:"totp_last_step"`
+	Username     string `json:"username"`
+}
+
+func (q *Queries) UseUserTotpStep(ctx context.Context, arg UseUserTotpStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useUserTotpStep, arg.TotpLastStep, arg.Username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -146,3 +146,120 @@ func TestUpdateUserAllFields(t *testing.T) {
 	require.NotEqual(t, oldUser.FullName, updatedUser.FullName)
 	require.Equal(t, newFullName, updatedUser.FullName)
 }
+
+func TestUserTotp(t *testing.T) {
+	user := createRandomUser(t)
+
+	user, err := testStore.SetUserTotpSecret(context.Background(), SetUserTotpSecretParams{
+		Username:   user.Username,
+		TotpSecret: util.RandomString(32),
+	})
+	require.NoError(t, err)
+	require.True(t, user.TotpSecret.Valid)
+	require.False(t, user.IsTotpEnabled)
+
+	recoveryCode := util.RandomString(64)
+	user, err = testStore.EnableUserTotp(context.Background(), EnableUserTotpParams{
+		Username:      user.Username,
+		TotpLastStep:  100,
+		RecoveryCodes: []string{recoveryCode},
+	})
+	require.NoError(t, err)
+	require.True(t, user.IsTotpEnabled)
+	require.Equal(t, int64(100), user.TotpLastStep)
+
+	// the secret cannot be replaced while two-factor is enabled
+	_, err = testStore.SetUserTotpSecret(context.Background(), SetUserTotpSecretParams{
+		Username:   user.Username,
+		TotpSecret: util.RandomString(32),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	// each time step can only be used once
+	rows, err := testStore.UseUserTotpStep(context.Background(), UseUserTotpStepParams{
+		Username:     user.Username,
+		TotpLastStep: 100,
+	})
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	rows, err = testStore.UseUserTotpStep(context.Background(), UseUserTotpStepParams{
+		Username:     user.Username,
+		TotpLastStep: 101,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	// and so can each recovery code
+	arg := UseUserRecoveryCodeParams{
+		Username:     user.Username,
+		RecoveryCode: recoveryCode,
+	}
+	rows, err = testStore.UseUserRecoveryCode(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	rows, err = testStore.UseUserRecoveryCode(context.Background(), arg)
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	user, err = testStore.DisableUserTotp(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.False(t, user.TotpSecret.Valid)
+	require.False(t, user.IsTotpEnabled)
+	require.Empty(t, user.RecoveryCodes)
+}
+
+func TestRehashUserPassword(t *testing.T) {
+	user := createRandomUser(t)
+
+	newHashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	// a stale hash does not overwrite a password changed meanwhile
+	rows, err := testStore.RehashUserPassword(context.Background(), RehashUserPasswordParams{
+		Username:          user.Username,
+		OldHashedPassword: "stale",
+		NewHashedPassword: newHashedPassword,
+	})
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	rows, err = testStore.RehashUserPassword(context.Background(), RehashUserPasswordParams{
+		Username:          user.Username,
+		OldHashedPassword: user.HashedPassword,
+		NewHashedPassword: newHashedPassword,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	updatedUser, err := testStore.GetUser(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, newHashedPassword, updatedUser.HashedPassword)
+	require.WithinDuration(t, user.PasswordChangedAt, updatedUser.PasswordChangedAt, time.Second)
+}
+
+func TestSearchUsers(t *testing.T) {
+	var users []User
+	for i := 0; i < 3; i++ {
+		users = append(users, createRandomUser(t))
+	}
+
+	// search by email, which is unique to each user
+	foundUsers, err := testStore.SearchUsers(context.Background(), SearchUsersParams{
+		Pattern: "%" + users[0].Email + "%",
+		Limit:   5,
+	})
+	require.NoError(t, err)
+	require.Len(t, foundUsers, 1)
+	require.Equal(t, users[0].Username, foundUsers[0].Username)
+
+	// the next page starts after the last username
+	foundUsers, err = testStore.SearchUsers(context.Background(), SearchUsersParams{
+		Pattern:       "%" + users[0].Email + "%",
+		AfterUsername: users[0].Username,
+		Limit:         5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, foundUsers)
+}
@@ -0,0 +1,183 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomWebhookSubscription(t *testing.T, owner string, eventTypes ...string) WebhookSubscription {
+	arg := CreateWebhookSubscriptionParams{
+		Owner:           owner,
+		Url:             "https://example.com/hooks/" + util.RandomString(8),
+		EventTypes:      eventTypes,
+		EncryptedSecret: util.RandomString(32),
+	}
+
+	subscription, err := testStore.CreateWebhookSubscription(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, subscription.ID)
+	require.Equal(t, arg.Owner, subscription.Owner)
+	require.Equal(t, arg.Url, subscription.Url)
+	require.Equal(t, arg.EventTypes, subscription.EventTypes)
+	require.Equal(t, arg.EncryptedSecret, subscription.EncryptedSecret)
+	require.NotZero(t, subscription.CreatedAt)
+
+	return subscription
+}
+
+// pendingWebhookTasks returns the payloads of the webhook deliveries waiting in the outbox for the subscription
+func pendingWebhookTasks(t *testing.T, subscription WebhookSubscription) []PayloadDeliverWebhook {
+	rows, err := testStore.(*SQLStore).connPool.Query(context.Background(),
+		`SELECT payload FROM outbox_tasks
+		WHERE task_type = $1 AND (payload->>'subscription_id')::bigint = $2 AND sent_at IS NULL
+		ORDER BY id`,
+		testEventTask.TaskType, subscription.ID,
+	)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var payloads []PayloadDeliverWebhook
+	for rows.Next() {
+		var raw []byte
+		require.NoError(t, rows.Scan(&raw))
+
+		var payload PayloadDeliverWebhook
+		require.NoError(t, json.Unmarshal(raw, &payload))
+		payloads = append(payloads, payload)
+	}
+	require.NoError(t, rows.Err())
+
+	return payloads
+}
+
+func TestWebhookSubscription(t *testing.T) {
+	user := createRandomUser(t)
+	subscription1 := createRandomWebhookSubscription(t, user.Username, util.EventTransferCreated)
+	subscription2 := createRandomWebhookSubscription(t, user.Username, util.EventAccountCreated, util.EventUserEmailVerified)
+
+	subscription, err := testStore.GetWebhookSubscription(context.Background(), subscription1.ID)
+	require.NoError(t, err)
+	require.Equal(t, subscription1, subscription)
+
+	subscriptions, err := testStore.ListWebhookSubscriptions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, []WebhookSubscription{subscription1, subscription2}, subscriptions)
+
+	subscriptions, err = testStore.ListWebhookSubscriptionsForEvent(context.Background(), ListWebhookSubscriptionsForEventParams{
+		Owners:    []string{user.Username, util.RandomOwner()},
+		EventType: util.EventAccountCreated,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []WebhookSubscription{subscription2}, subscriptions)
+
+	err = testStore.DeleteWebhookSubscription(context.Background(), subscription1.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetWebhookSubscription(context.Background(), subscription1.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	user := createRandomUser(t)
+	subscription := createRandomWebhookSubscription(t, user.Username, util.EventTransferCreated)
+
+	n := 5
+	eventID := uuid.New()
+	for i := 0; i < n; i++ {
+		delivery, err := testStore.CreateWebhookDelivery(context.Background(), CreateWebhookDeliveryParams{
+			SubscriptionID: subscription.ID,
+			EventID:        eventID,
+			EventType:      util.EventTransferCreated,
+			Attempt:        int32(i + 1),
+			StatusCode:     500,
+			Error:          "webhook answered with status 500",
+		})
+		require.NoError(t, err)
+		require.NotZero(t, delivery.ID)
+		require.Equal(t, eventID, delivery.EventID)
+	}
+
+	deliveries, err := testStore.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesParams{
+		SubscriptionID: subscription.ID,
+		Limit:          int32(n - 2),
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, n-2)
+	require.Equal(t, int32(1), deliveries[0].Attempt)
+
+	last := deliveries[len(deliveries)-1]
+	deliveries, err = testStore.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesParams{
+		SubscriptionID: subscription.ID,
+		AfterCreatedAt: last.CreatedAt,
+		AfterID:        last.ID,
+		Limit:          int32(n),
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, int32(n), deliveries[1].Attempt)
+
+	// the delivery log goes away with the subscription
+	err = testStore.DeleteWebhookSubscription(context.Background(), subscription.ID)
+	require.NoError(t, err)
+
+	deliveries, err = testStore.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesParams{
+		SubscriptionID: subscription.ID,
+		Limit:          int32(n),
+	})
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+}
+
+func TestCreateAccountTxEmitsAccountCreated(t *testing.T) {
+	user := createRandomUser(t)
+	subscription := createRandomWebhookSubscription(t, user.Username, util.EventAccountCreated)
+	ignored := createRandomWebhookSubscription(t, user.Username, util.EventTransferCreated)
+
+	result, err := testStore.CreateAccountTx(context.Background(), CreateAccountTxParams{
+		CreateAccountParams: CreateAccountParams{
+			Owner:    user.Username,
+			Balance:  0,
+			Currency: util.RandomCurrency(),
+		},
+	})
+	require.NoError(t, err)
+
+	payloads := pendingWebhookTasks(t, subscription)
+	require.Len(t, payloads, 1)
+	require.Equal(t, util.EventAccountCreated, payloads[0].Event.Type)
+	require.WithinDuration(t, time.Now(), payloads[0].Event.OccurredAt, time.Minute)
+
+	var account Account
+	require.NoError(t, json.Unmarshal(payloads[0].Event.Data, &account))
+	require.Equal(t, result.Account.ID, account.ID)
+
+	require.Empty(t, pendingWebhookTasks(t, ignored))
+}
+
+func TestTransferTxEmitsTransferCreated(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	subscription1 := createRandomWebhookSubscription(t, account1.Owner, util.EventTransferCreated)
+	subscription2 := createRandomWebhookSubscription(t, account2.Owner, util.EventTransferCreated)
+
+	_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	// both owners hear about the same event
+	payloads1 := pendingWebhookTasks(t, subscription1)
+	payloads2 := pendingWebhookTasks(t, subscription2)
+	require.Len(t, payloads1, 1)
+	require.Len(t, payloads2, 1)
+	require.Equal(t, util.EventTransferCreated, payloads1[0].Event.Type)
+	require.Equal(t, payloads1[0].Event.ID, payloads2[0].Event.ID)
+}
@@ -8,8 +8,13 @@ CREATE TABLE "users" (
   "hashed_password" varchar NOT NULL,
   "full_name" varchar NOT NULL,
   "email" varchar UNIQUE NOT NULL,
+  "pending_email" varchar,
   "is_email_verified" bool NOT NULL DEFAULT false,
   "password_changed_at" timestamptz NOT NULL DEFAULT '0001-01-01',
+  "totp_secret" varchar,
+  "is_totp_enabled" bool NOT NULL DEFAULT false,
+  "totp_last_step" bigint NOT NULL DEFAULT 0,
+  "recovery_codes" varchar[] NOT NULL DEFAULT '{}',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -23,11 +28,22 @@ CREATE TABLE "verify_emails" (
   "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '15 minutes')
 );
 
+CREATE TABLE "password_resets" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "secret_code" varchar NOT NULL,
+  "is_used" bool NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '15 minutes')
+);
+
 CREATE TABLE "accounts" (
   "id" bigserial PRIMARY KEY,
   "owner" varchar NOT NULL,
   "balance" bigint NOT NULL,
   "currency" varchar NOT NULL,
+  "overdraft_limit" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -35,7 +51,8 @@ CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "balance" bigint NOT NULL
 );
 
 CREATE TABLE "transfers" (
@@ -43,7 +60,9 @@ CREATE TABLE "transfers" (
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "to_amount" bigint NOT NULL,
+  "exchange_rate" numeric(20, 10) NOT NULL DEFAULT 1
 );
 
 CREATE TABLE "sessions" (
@@ -53,28 +72,223 @@ CREATE TABLE "sessions" (
   "user_agent" varchar NOT NULL,
   "client_ip" varchar NOT NULL,
   "is_blocked" boolean NOT NULL DEFAULT false,
+  "family_id" uuid NOT NULL,
+  "parent_id" uuid,
+  "is_rotated" boolean NOT NULL DEFAULT false,
   "expires_at" timestamptz NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
+CREATE TABLE "idempotency_keys" (
+  "from_account_id" bigint NOT NULL,
+  "idempotency_key" varchar NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "transfer_id" bigint NOT NULL,
+  "result" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("from_account_id", "idempotency_key")
+);
+
+CREATE TABLE "exchange_rates" (
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "rate" numeric(20, 10) NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("from_currency", "to_currency")
+);
+
+CREATE TABLE "roles" (
+  "name" varchar PRIMARY KEY,
+  "description" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "role_permissions" (
+  "role" varchar NOT NULL,
+  "permission" varchar NOT NULL,
+  PRIMARY KEY ("role", "permission")
+);
+
+CREATE TABLE "account_adjustments" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "entry_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "reason" varchar NOT NULL,
+  "created_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_status_changes" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "from_status" varchar NOT NULL,
+  "to_status" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "changed_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "audit_events" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "target_type" varchar NOT NULL,
+  "target_id" varchar NOT NULL,
+  "before" jsonb,
+  "after" jsonb,
+  "client_ip" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "outbox_tasks" (
+  "id" bigserial PRIMARY KEY,
+  "task_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "queue" varchar NOT NULL,
+  "max_retry" int NOT NULL,
+  "process_at" timestamptz NOT NULL DEFAULT (now()),
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz,
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_subscriptions" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "url" varchar NOT NULL,
+  "event_types" varchar[] NOT NULL,
+  "encrypted_secret" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+  "id" bigserial PRIMARY KEY,
+  "subscription_id" bigint NOT NULL,
+  "event_id" uuid NOT NULL,
+  "event_type" varchar NOT NULL,
+  "attempt" int NOT NULL,
+  "status_code" int NOT NULL,
+  "error" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "verify_emails" ("username", "created_at");
+
+CREATE INDEX ON "password_resets" ("username");
+
 CREATE INDEX ON "accounts" ("owner");
 
 CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
 
+CREATE INDEX ON "accounts" ("owner", "created_at", "id");
+
 CREATE INDEX ON "entries" ("account_id");
 
+CREATE INDEX ON "entries" ("account_id", "created_at", "id");
+
 CREATE INDEX ON "transfers" ("from_account_id");
 
 CREATE INDEX ON "transfers" ("to_account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
+CREATE INDEX ON "transfers" ("from_account_id", "created_at", "id");
+
+CREATE INDEX ON "transfers" ("to_account_id", "created_at", "id");
+
+CREATE INDEX ON "sessions" ("family_id");
+
+CREATE INDEX ON "sessions" ("username");
+
+CREATE INDEX ON "account_adjustments" ("account_id");
+
+CREATE INDEX ON "account_status_changes" ("account_id");
+
+CREATE INDEX ON "audit_events" ("created_at", "id");
+
+CREATE INDEX ON "audit_events" ("actor", "created_at", "id");
+
+CREATE INDEX ON "audit_events" ("target_type", "target_id", "created_at", "id");
+
+CREATE INDEX ON "outbox_tasks" ("id") WHERE "sent_at" IS NULL;
+
+CREATE INDEX ON "webhook_subscriptions" ("owner");
+
+CREATE INDEX ON "webhook_deliveries" ("subscription_id", "created_at", "id");
+
+COMMENT ON COLUMN "users"."pending_email" IS 'new email address waiting to be verified before it replaces email';
+
+COMMENT ON COLUMN "users"."totp_secret" IS 'encrypted with TOTP_ENCRYPTION_KEY';
+
+COMMENT ON COLUMN "users"."totp_last_step" IS 'time step of the last accepted code, to refuse replays';
+
+COMMENT ON COLUMN "users"."recovery_codes" IS 'sha256 hashes of the unused recovery codes';
+
+COMMENT ON COLUMN "accounts"."overdraft_limit" IS 'balance must never drop below -overdraft_limit';
+
+COMMENT ON COLUMN "accounts"."status" IS 'money only moves in or out of an active account';
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be negative or positive';
 
+COMMENT ON COLUMN "entries"."balance" IS 'account balance right after this entry';
+
 COMMENT ON COLUMN "transfers"."amount" IS 'must be positive';
 
+COMMENT ON COLUMN "transfers"."to_amount" IS 'amount credited to the receiver, in its currency';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS 'rate applied to amount to get to_amount';
+
+COMMENT ON COLUMN "sessions"."family_id" IS 'id of the login session this one was rotated from';
+
+COMMENT ON COLUMN "sessions"."is_rotated" IS 'its refresh token was exchanged for a new session';
+
+COMMENT ON COLUMN "idempotency_keys"."result" IS 'the TransferTxResult returned to the first request';
+
+COMMENT ON COLUMN "exchange_rates"."rate" IS 'units of to_currency for one unit of from_currency';
+
+COMMENT ON COLUMN "role_permissions"."permission" IS 'resource:action:scope, an any scope also grants the own scope';
+
+COMMENT ON COLUMN "account_adjustments"."amount" IS 'can be negative or positive';
+
+COMMENT ON COLUMN "account_adjustments"."created_by" IS 'banker who posted the adjustment';
+
+COMMENT ON COLUMN "account_status_changes"."changed_by" IS 'owner or banker who changed the status';
+
+COMMENT ON TABLE "audit_events" IS 'append only, a trigger refuses updates and deletes';
+
+COMMENT ON COLUMN "audit_events"."actor" IS 'username of who made the change, empty when unknown';
+
+COMMENT ON COLUMN "audit_events"."before" IS 'changed fields before the change, secrets are redacted';
+
+COMMENT ON COLUMN "audit_events"."after" IS 'changed fields after the change, secrets are redacted';
+
+COMMENT ON COLUMN "outbox_tasks"."process_at" IS 'the worker must not process the task before this time';
+
+COMMENT ON COLUMN "outbox_tasks"."attempts" IS 'failed attempts to publish the task';
+
+COMMENT ON COLUMN "outbox_tasks"."sent_at" IS 'when the relay published the task, null while pending';
+
+COMMENT ON COLUMN "outbox_tasks"."next_attempt_at" IS 'the relay must not publish the task before this time, pushed back while it is claimed and after each failure';
+
+COMMENT ON COLUMN "webhook_subscriptions"."owner" IS 'events about this user and their accounts are delivered';
+
+COMMENT ON COLUMN "webhook_subscriptions"."encrypted_secret" IS 'key of the HMAC-SHA256 signature, encrypted with WEBHOOK_ENCRYPTION_KEY';
+
+COMMENT ON COLUMN "webhook_deliveries"."status_code" IS 'HTTP status of the response, 0 when there was none';
+
+COMMENT ON COLUMN "webhook_deliveries"."error" IS 'empty when the webhook answered with a 2xx status';
+
+ALTER TABLE "users" ADD FOREIGN KEY ("role") REFERENCES "roles" ("name");
+
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
+ALTER TABLE "password_resets" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
 
 ALTER TABLE "entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -84,3 +298,25 @@ ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "sessions" ADD FOREIGN KEY ("parent_id") REFERENCES "sessions" ("id");
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "role_permissions" ADD FOREIGN KEY ("role") REFERENCES "roles" ("name");
+
+ALTER TABLE "account_adjustments" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_adjustments" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "account_adjustments" ADD FOREIGN KEY ("created_by") REFERENCES "users" ("username");
+
+ALTER TABLE "account_status_changes" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_status_changes" ADD FOREIGN KEY ("changed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_subscriptions" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("subscription_id") REFERENCES "webhook_subscriptions" ("id") ON DELETE CASCADE;
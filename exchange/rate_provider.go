@@ -0,0 +1,63 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+)
+
+// ErrRateNotFound is returned when no exchange rate is known for a currency pair
+var ErrRateNotFound = errors.New("exchange rate not found")
+
+// RateProvider provides the rates used to convert money between currencies
+type RateProvider interface {
+	// GetRate returns the number of units of toCurrency for one unit of fromCurrency
+	GetRate(ctx context.Context, fromCurrency string, toCurrency string) (pgtype.Numeric, error)
+}
+
+// DBRateProvider reads exchange rates from the exchange_rates table
+type DBRateProvider struct {
+	store db.Querier
+}
+
+// NewDBRateProvider creates a new RateProvider backed by the database
+func NewDBRateProvider(store db.Querier) RateProvider {
+	return &DBRateProvider{
+		store: store,
+	}
+}
+
+// GetRate looks up the rate for the currency pair, falling back to the inverse of the reverse pair
+// rounded half to even to db.ExchangeRateScale decimals
+func (provider *DBRateProvider) GetRate(ctx context.Context, fromCurrency string, toCurrency string) (pgtype.Numeric, error) {
+	if fromCurrency == toCurrency {
+		return db.IdentityExchangeRate(), nil
+	}
+
+	rate, err := provider.store.GetExchangeRate(ctx, db.GetExchangeRateParams{
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+	})
+	if err == nil {
+		return rate.Rate, nil
+	}
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		return pgtype.Numeric{}, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+
+	rate, err = provider.store.GetExchangeRate(ctx, db.GetExchangeRateParams{
+		FromCurrency: toCurrency,
+		ToCurrency:   fromCurrency,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return pgtype.Numeric{}, fmt.Errorf("%w: %s to %s", ErrRateNotFound, fromCurrency, toCurrency)
+		}
+		return pgtype.Numeric{}, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+
+	return db.InvertExchangeRate(rate.Rate)
+}
@@ -0,0 +1,132 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func exchangeRate(value string) pgtype.Numeric {
+	rate, err := db.ParseExchangeRate(value)
+	if err != nil {
+		panic(err)
+	}
+	return rate
+}
+
+func TestDBRateProvider(t *testing.T) {
+	testCases := []struct {
+		name       string
+		from       string
+		to         string
+		buildStubs func(store *mockdb.MockStore)
+		checkRate  func(t *testing.T, rate pgtype.Numeric, err error)
+	}{
+		{
+			name: "SameCurrency",
+			from: util.USD,
+			to:   util.USD,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRate: func(t *testing.T, rate pgtype.Numeric, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1", db.FormatExchangeRate(rate))
+			},
+		},
+		{
+			name: "DirectRate",
+			from: util.USD,
+			to:   util.EUR,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.GetExchangeRateParams{FromCurrency: util.USD, ToCurrency: util.EUR}
+				store.EXPECT().GetExchangeRate(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.ExchangeRate{FromCurrency: util.USD, ToCurrency: util.EUR, Rate: exchangeRate("0.8")}, nil)
+			},
+			checkRate: func(t *testing.T, rate pgtype.Numeric, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "0.8", db.FormatExchangeRate(rate))
+			},
+		},
+		{
+			name: "InverseRate",
+			from: util.EUR,
+			to:   util.USD,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg1 := db.GetExchangeRateParams{FromCurrency: util.EUR, ToCurrency: util.USD}
+				store.EXPECT().GetExchangeRate(gomock.Any(), gomock.Eq(arg1)).Times(1).
+					Return(db.ExchangeRate{}, db.ErrRecordNotFound)
+
+				arg2 := db.GetExchangeRateParams{FromCurrency: util.USD, ToCurrency: util.EUR}
+				store.EXPECT().GetExchangeRate(gomock.Any(), gomock.Eq(arg2)).Times(1).
+					Return(db.ExchangeRate{FromCurrency: util.USD, ToCurrency: util.EUR, Rate: exchangeRate("0.8")}, nil)
+			},
+			checkRate: func(t *testing.T, rate pgtype.Numeric, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.25", db.FormatExchangeRate(rate))
+			},
+		},
+		{
+			name: "InverseRateRounded",
+			from: util.EUR,
+			to:   util.USD,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.ExchangeRate{}, db.ErrRecordNotFound)
+				store.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.ExchangeRate{FromCurrency: util.USD, ToCurrency: util.EUR, Rate: exchangeRate("0.6")}, nil)
+			},
+			checkRate: func(t *testing.T, rate pgtype.Numeric, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.6666666667", db.FormatExchangeRate(rate))
+			},
+		},
+		{
+			name: "NotFound",
+			from: util.EUR,
+			to:   util.CAD,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).Times(2).
+					Return(db.ExchangeRate{}, db.ErrRecordNotFound)
+			},
+			checkRate: func(t *testing.T, rate pgtype.Numeric, err error) {
+				require.ErrorIs(t, err, ErrRateNotFound)
+			},
+		},
+		{
+			name: "InternalError",
+			from: util.EUR,
+			to:   util.CAD,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.ExchangeRate{}, db.ErrUniqueViolation)
+			},
+			checkRate: func(t *testing.T, rate pgtype.Numeric, err error) {
+				require.Error(t, err)
+				require.NotErrorIs(t, err, ErrRateNotFound)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			provider := NewDBRateProvider(store)
+			rate, err := provider.GetRate(context.Background(), tc.from, tc.to)
+			tc.checkRate(t, rate, err)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package gapi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+)
+
+// AccountWatcher follows the activity of accounts for the WatchAccount RPC
+type AccountWatcher interface {
+	// WatchAccount returns a channel receiving the activity of the account until ctx is done.
+	// The channel is closed if the watcher misses some activity.
+	WatchAccount(ctx context.Context, accountID int64) <-chan db.AccountActivity
+}
+
+const (
+	// accountWatchBuffer is how much activity a watcher may fall behind before it is dropped
+	accountWatchBuffer = 16
+
+	accountActivityRetryDelay = 5 * time.Second
+)
+
+// AccountActivityHub fans the notifications of db.AccountActivityChannel out to the watchers of each account
+type AccountActivityHub struct {
+	mu       sync.Mutex
+	watchers map[int64]map[chan db.AccountActivity]struct{}
+}
+
+// NewAccountActivityHub creates a new AccountWatcher. It receives nothing until Listen runs.
+func NewAccountActivityHub() *AccountActivityHub {
+	return &AccountActivityHub{
+		watchers: make(map[int64]map[chan db.AccountActivity]struct{}),
+	}
+}
+
+func (hub *AccountActivityHub) WatchAccount(ctx context.Context, accountID int64) <-chan db.AccountActivity {
+	activities := make(chan db.AccountActivity, accountWatchBuffer)
+
+	hub.mu.Lock()
+	if hub.watchers[accountID] == nil {
+		hub.watchers[accountID] = make(map[chan db.AccountActivity]struct{})
+	}
+	hub.watchers[accountID][activities] = struct{}{}
+	hub.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		hub.remove(accountID, activities)
+	}()
+
+	return activities
+}
+
+// Publish sends the activity to the watchers of its account.
+// A watcher that is too far behind to take it is dropped.
+func (hub *AccountActivityHub) Publish(activity db.AccountActivity) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	accountID := activity.Account.ID
+	for activities := range hub.watchers[accountID] {
+		select {
+		case activities <- activity:
+		default:
+			if hub.remove(accountID, activities) {
+				close(activities)
+			}
+		}
+	}
+}
+
+// Listen feeds the hub from db.AccountActivityChannel with a dedicated connection to dbSource until ctx is done.
+// When the connection fails, every watcher is dropped since notifications may have been lost, and it connects again.
+func (hub *AccountActivityHub) Listen(ctx context.Context, dbSource string) error {
+	for {
+		err := hub.listen(ctx, dbSource)
+		hub.dropAll()
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.Error().Err(err).Msg("account activity listener failed")
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(accountActivityRetryDelay):
+		}
+	}
+}
+
+func (hub *AccountActivityHub) listen(ctx context.Context, dbSource string) error {
+	conn, err := pgx.Connect(ctx, dbSource)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	return db.ListenAccountActivity(ctx, conn, hub.Publish)
+}
+
+// remove forgets a watcher and reports whether it was still there. The caller holds the lock.
+func (hub *AccountActivityHub) remove(accountID int64, activities chan db.AccountActivity) bool {
+	if _, ok := hub.watchers[accountID][activities]; !ok {
+		return false
+	}
+
+	delete(hub.watchers[accountID], activities)
+	if len(hub.watchers[accountID]) == 0 {
+		delete(hub.watchers, accountID)
+	}
+	return true
+}
+
+func (hub *AccountActivityHub) dropAll() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	for accountID, watchers := range hub.watchers {
+		for activities := range watchers {
+			close(activities)
+		}
+		delete(hub.watchers, accountID)
+	}
+}
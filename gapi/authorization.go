@@ -6,7 +6,10 @@ import (
 	"strings"
 
 	"github.com/spaghetti-lover/simplebank/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -14,7 +17,109 @@ const (
 	authorizationBearer = "bearer"
 )
 
-func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []string) (*token.Payload, error) {
+type authPayloadKey struct{}
+
+// AuthorizationInterceptor refuses gRPC calls without the permission methodPermissions requires.
+// Methods missing from the table are refused.
+func (server *Server) AuthorizationInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	ctx, err = server.authorizeMethod(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// AuthorizationStreamInterceptor is the AuthorizationInterceptor of streaming calls
+func (server *Server) AuthorizationStreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := server.authorizeMethod(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authorizedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authorizeMethod checks the permission of the method and returns the context the handler runs with
+func (server *Server) authorizeMethod(ctx context.Context, fullMethod string) (context.Context, error) {
+	permission, ok := methodPermissions[fullMethod]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "method %s is not allowed", fullMethod)
+	}
+
+	if permission == publicMethod {
+		return ctx, nil
+	}
+
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	err = server.checkPermission(ctx, payload, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	// the handler reuses the payload instead of verifying the token again
+	return context.WithValue(ctx, authPayloadKey{}, payload), nil
+}
+
+// authorizedStream carries the context of authorizeMethod to the stream handler
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *authorizedStream) Context() context.Context {
+	return stream.ctx
+}
+
+// authorizeUser returns the payload of the access token if its role grants the permission.
+// Calls coming through the gateway skip the interceptor, so the handlers check the permission as well.
+func (server *Server) authorizeUser(ctx context.Context, permission string) (*token.Payload, error) {
+	payload, ok := ctx.Value(authPayloadKey{}).(*token.Payload)
+	if !ok {
+		var err error
+		payload, err = server.authenticateUser(ctx)
+		if err != nil {
+			return nil, unauthenticatedError(err)
+		}
+	}
+
+	err := server.checkPermission(ctx, payload, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// checkPermission returns a PermissionDenied error unless the role of the payload grants the permission
+func (server *Server) checkPermission(ctx context.Context, payload *token.Payload, permission string) error {
+	granted, err := server.permissions.HasPermission(ctx, payload.Role, permission)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permission: %s", err)
+	}
+
+	if !granted {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %s", permission)
+	}
+
+	return nil
+}
+
+func (server *Server) authenticateUser(ctx context.Context) (*token.Payload, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, fmt.Errorf("missing metadata")
@@ -42,18 +147,10 @@ func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []strin
 		return nil, fmt.Errorf("invalid access token: %s", err)
 	}
 
-	if !hasPermission(payload.Role, accessibleRoles) {
-		return nil, fmt.Errorf("permission denied")
+	err = server.sessionChecker.CheckSession(ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("revoked access token: %s", err)
 	}
 
 	return payload, nil
 }
-
-func hasPermission(userRole string, accessibleRoles []string) bool {
-	for _, role := range accessibleRoles {
-		if userRole == role {
-			return true
-		}
-	}
-	return false
-}
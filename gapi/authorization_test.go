@@ -0,0 +1,287 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spaghetti-lover/simplebank/auth"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorizeUser(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, payload *token.Payload, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{
+						Username:          user.Username,
+						PasswordChangedAt: user.PasswordChangedAt,
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, payload.Username)
+			},
+		},
+		{
+			name: "BlockedSession",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{
+						Username:  user.Username,
+						IsBlocked: true,
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, err error) {
+				require.ErrorContains(t, err, auth.ErrSessionBlocked.Error())
+				require.Nil(t, payload)
+			},
+		},
+		{
+			name: "PasswordChanged",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{
+						Username:          user.Username,
+						PasswordChangedAt: time.Now().Add(time.Minute),
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, err error) {
+				require.ErrorContains(t, err, auth.ErrPasswordChanged.Error())
+				require.Nil(t, payload)
+			},
+		},
+		{
+			name: "SessionNotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSessionAuthState(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetSessionAuthStateRow{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, err error) {
+				require.ErrorContains(t, err, auth.ErrSessionNotFound.Error())
+				require.Nil(t, payload)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			server.sessionChecker = auth.NewCachedSessionChecker(store, time.Minute)
+
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			payload, err := server.authorizeUser(ctx, util.PermissionAccountsReadOwn)
+			tc.checkResponse(t, payload, err)
+		})
+	}
+}
+
+func TestAuthorizeUserPermission(t *testing.T) {
+	depositor, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	testCases := []struct {
+		name       string
+		user       db.User
+		permission string
+		code       codes.Code
+	}{
+		{
+			name:       "OwnScope",
+			user:       depositor,
+			permission: util.PermissionAccountsReadOwn,
+			code:       codes.OK,
+		},
+		{
+			name:       "AnyScopeGrantsOwnScope",
+			user:       banker,
+			permission: util.PermissionAccountsReadOwn,
+			code:       codes.OK,
+		},
+		{
+			name:       "AnyScope",
+			user:       banker,
+			permission: util.PermissionRolesAssignAny,
+			code:       codes.OK,
+		},
+		{
+			name:       "PermissionDenied",
+			user:       depositor,
+			permission: util.PermissionAccountsReadAny,
+			code:       codes.PermissionDenied,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, nil, nil)
+
+			ctx := newContextWithBearerToken(t, server.tokenMaker, tc.user.Username, tc.user.Role, time.Minute, token.TokenTypeAccessToken)
+			payload, err := server.authorizeUser(ctx, tc.permission)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, tc.user.Username, payload.Username)
+			}
+		})
+	}
+}
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	depositor, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	testCases := []struct {
+		name         string
+		method       string
+		buildContext func(t *testing.T, tokenMaker token.Maker) context.Context
+		code         codes.Code
+		called       bool
+	}{
+		{
+			name:   "PublicMethod",
+			method: "/pb.SimpleBank/LoginUser",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			code:   codes.OK,
+			called: true,
+		},
+		{
+			name:   "OK",
+			method: "/pb.SimpleBank/AssignRole",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			code:   codes.OK,
+			called: true,
+		},
+		{
+			name:   "PermissionDenied",
+			method: "/pb.SimpleBank/AssignRole",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:   "NoAuthorization",
+			method: "/pb.SimpleBank/GetAccount",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:   "UnknownMethod",
+			method: "/pb.SimpleBank/Unknown",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			code: codes.PermissionDenied,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, nil, nil)
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+
+				// the handler gets the payload without verifying the token again
+				if methodPermissions[tc.method] != publicMethod {
+					payload, err := server.authorizeUser(metadata.NewIncomingContext(ctx, nil), methodPermissions[tc.method])
+					require.NoError(t, err)
+					require.Equal(t, banker.Username, payload.Username)
+				}
+				return nil, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, err := server.AuthorizationInterceptor(tc.buildContext(t, server.tokenMaker), nil, info, handler)
+			require.Equal(t, tc.code, status.Code(err))
+			require.Equal(t, tc.called, called)
+		})
+	}
+}
+
+func TestAuthorizationStreamInterceptor(t *testing.T) {
+	depositor, _ := randomUser(t, util.DepositorRole)
+	server := newTestServer(t, nil, nil)
+
+	info := &grpc.StreamServerInfo{FullMethod: "/pb.SimpleBank/WatchAccount", IsServerStream: true}
+
+	called := false
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		called = true
+
+		// the handler gets the payload from the context of the stream
+		payload, err := server.authorizeUser(stream.Context(), util.PermissionAccountsReadOwn)
+		require.NoError(t, err)
+		require.Equal(t, depositor.Username, payload.Username)
+		return nil
+	}
+
+	ctx := newContextWithBearerToken(t, server.tokenMaker, depositor.Username, depositor.Role, time.Minute, token.TokenTypeAccessToken)
+	err := server.AuthorizationStreamInterceptor(nil, newTestWatchAccountStream(ctx), info, handler)
+	require.NoError(t, err)
+	require.True(t, called)
+
+	called = false
+	err = server.AuthorizationStreamInterceptor(nil, newTestWatchAccountStream(context.Background()), info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.False(t, called)
+}
+
+func TestMethodPermissions(t *testing.T) {
+	// every method must be listed, or the interceptors refuse it
+	for _, method := range pb.SimpleBank_ServiceDesc.Methods {
+		fullMethod := fmt.Sprintf("/%s/%s", pb.SimpleBank_ServiceDesc.ServiceName, method.MethodName)
+		require.Contains(t, methodPermissions, fullMethod)
+	}
+	for _, stream := range pb.SimpleBank_ServiceDesc.Streams {
+		fullMethod := fmt.Sprintf("/%s/%s", pb.SimpleBank_ServiceDesc.ServiceName, stream.StreamName)
+		require.Contains(t, methodPermissions, fullMethod)
+	}
+	require.Len(t, methodPermissions, len(pb.SimpleBank_ServiceDesc.Methods)+len(pb.SimpleBank_ServiceDesc.Streams))
+}
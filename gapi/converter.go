@@ -1,17 +1,162 @@
 package gapi
 
 import (
+	"fmt"
+
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"github.com/spaghetti-lover/simplebank/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func convertUser(user db.User) *pb.User {
-	return &pb.User{
-		Username:          user.Username,
-		FullName:          user.FullName,
-		Email:             user.Email,
-		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
-		CreatedAt:         timestamppb.New(user.CreatedAt),
+	pbUser := &pb.User{
+		Username:           user.Username,
+		FullName:           user.FullName,
+		Email:              user.Email,
+		PasswordChangedAt:  timestamppb.New(user.PasswordChangedAt),
+		CreatedAt:          timestamppb.New(user.CreatedAt),
+		IsTwoFactorEnabled: user.IsTotpEnabled,
+		Role:               user.Role,
+	}
+	if user.PendingEmail.Valid {
+		pbUser.PendingEmail = &user.PendingEmail.String
+	}
+	return pbUser
+}
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:             account.ID,
+		Owner:          account.Owner,
+		Balance:        account.Balance,
+		Currency:       account.Currency,
+		CreatedAt:      timestamppb.New(account.CreatedAt),
+		OverdraftLimit: account.OverdraftLimit,
+		Status:         account.Status,
+	}
+}
+
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:            transfer.ID,
+		FromAccountId: transfer.FromAccountID,
+		ToAccountId:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+		ToAmount:      transfer.ToAmount,
+		ExchangeRate:  db.FormatExchangeRate(transfer.ExchangeRate),
+	}
+}
+
+func convertExchangeRate(rate db.ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         db.FormatExchangeRate(rate.Rate),
+		UpdatedAt:    timestamppb.New(rate.UpdatedAt),
+	}
+}
+
+func convertEntry(entry db.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:        entry.ID,
+		AccountId: entry.AccountID,
+		Amount:    entry.Amount,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+		Balance:   entry.Balance,
+	}
+}
+
+func convertSession(session db.Session) *pb.Session {
+	return &pb.Session{
+		Id:        session.ID.String(),
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		CreatedAt: timestamppb.New(session.CreatedAt),
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+	}
+}
+
+func convertRole(role db.Role, permissions []string) *pb.Role {
+	return &pb.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+	}
+}
+
+func convertAccountAdjustment(adjustment db.AccountAdjustment) *pb.AccountAdjustment {
+	return &pb.AccountAdjustment{
+		Id:        adjustment.ID,
+		AccountId: adjustment.AccountID,
+		EntryId:   adjustment.EntryID,
+		Amount:    adjustment.Amount,
+		Reason:    adjustment.Reason,
+		CreatedBy: adjustment.CreatedBy,
+		CreatedAt: timestamppb.New(adjustment.CreatedAt),
+	}
+}
+
+func convertAuditEvent(event db.AuditEvent) (*pb.AuditEvent, error) {
+	before, err := convertAuditFields(event.Before)
+	if err != nil {
+		return nil, fmt.Errorf("invalid before of audit event [%d]: %w", event.ID, err)
+	}
+
+	after, err := convertAuditFields(event.After)
+	if err != nil {
+		return nil, fmt.Errorf("invalid after of audit event [%d]: %w", event.ID, err)
+	}
+
+	return &pb.AuditEvent{
+		Id:         event.ID,
+		Actor:      event.Actor,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetId:   event.TargetID,
+		Before:     before,
+		After:      after,
+		ClientIp:   event.ClientIp,
+		UserAgent:  event.UserAgent,
+		CreatedAt:  timestamppb.New(event.CreatedAt),
+	}, nil
+}
+
+// convertAuditFields turns the JSON object of changed fields into a Struct, nil when there are none
+func convertAuditFields(data []byte) (*structpb.Struct, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	fields := &structpb.Struct{}
+	err := protojson.Unmarshal(data, fields)
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func convertWebhookSubscription(subscription db.WebhookSubscription) *pb.WebhookSubscription {
+	return &pb.WebhookSubscription{
+		Id:         subscription.ID,
+		Owner:      subscription.Owner,
+		Url:        subscription.Url,
+		EventTypes: subscription.EventTypes,
+		CreatedAt:  timestamppb.New(subscription.CreatedAt),
+	}
+}
+
+func convertWebhookDelivery(delivery db.WebhookDelivery) *pb.WebhookDelivery {
+	return &pb.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.EventID.String(),
+		EventType:      delivery.EventType,
+		Attempt:        delivery.Attempt,
+		StatusCode:     delivery.StatusCode,
+		Error:          delivery.Error,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
 	}
 }
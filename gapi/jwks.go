@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/simplebank/token"
+)
+
+// JWKSPath is where the gateway publishes the public keys of the token maker
+const JWKSPath = "/.well-known/jwks.json"
+
+// JWKSHandler serves the public keys other services need to verify our tokens.
+// It responds with 404 when tokens are signed with a shared secret.
+func (server *Server) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			res.Header().Set("Allow", "GET, HEAD")
+			http.Error(res, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		provider, ok := server.tokenMaker.(token.KeySetProvider)
+		if !ok {
+			http.NotFound(res, req)
+			return
+		}
+
+		keySet := provider.KeySet()
+		if len(keySet.Keys) == 0 {
+			http.NotFound(res, req)
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("Cache-Control", "public, max-age=300")
+		err := json.NewEncoder(res).Encode(keySet)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to write JWKS response")
+		}
+	})
+}
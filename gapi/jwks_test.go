@@ -0,0 +1,90 @@
+package gapi
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKSHandler(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		config        util.Config
+		method        string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			config: util.Config{
+				TokenMaker:        token.JWTES256,
+				TokenSigningKeyID: "key-1",
+				TokenSigningKey:   string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})),
+			},
+			method: http.MethodGet,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+				var keySet token.JSONWebKeySet
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &keySet))
+				require.Len(t, keySet.Keys, 1)
+				require.Equal(t, "key-1", keySet.Keys[0].KeyID)
+				require.Equal(t, "EC", keySet.Keys[0].KeyType)
+				require.Equal(t, "ES256", keySet.Keys[0].Algorithm)
+			},
+		},
+		{
+			name: "SymmetricKey",
+			config: util.Config{
+				TokenSymmetricKey: util.RandomString(32),
+			},
+			method: http.MethodGet,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "MethodNotAllowed",
+			config: util.Config{
+				TokenMaker:        token.JWTES256,
+				TokenSigningKeyID: "key-1",
+				TokenSigningKey:   string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})),
+			},
+			method: http.MethodPost,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server, err := NewServer(tc.config, nil, nil, nil)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(tc.method, JWKSPath, nil)
+
+			server.JWKSHandler().ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
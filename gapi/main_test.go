@@ -3,9 +3,12 @@ package gapi
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/auth"
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"github.com/spaghetti-lover/simplebank/token"
 	"github.com/spaghetti-lover/simplebank/util"
@@ -16,18 +19,55 @@ import (
 
 func newTestServer(t *testing.T, store db.Store, taskDistributor worker.TaskDistributor) *Server {
 	config := util.Config{
-		TokenSymmetricKey:   util.RandomString(32),
-		AccessTokenDuration: time.Minute,
+		TokenSymmetricKey:     util.RandomString(32),
+		AccessTokenDuration:   time.Minute,
+		MFATokenDuration:      time.Minute,
+		TOTPIssuer:            "Simple Bank",
+		TOTPEncryptionKey:     util.RandomString(32),
+		WebhookEncryptionKey:  util.RandomString(32),
+		VerifyEmailInterval:   time.Minute,
+		PasswordResetInterval: time.Minute,
 	}
 
-	server, err := NewServer(config, store, taskDistributor)
+	server, err := NewServer(config, store, taskDistributor, nil)
 	require.NoError(t, err)
 
+	// sessions are covered by TestAuthorizeUser, other tests only stub their own queries
+	server.sessionChecker = activeSessions{}
+	// and the policy by TestVerifiedEmailPolicy
+	server.policy = allowAllPolicy{}
+	// and the permissions are the ones seeded in the database
+	server.permissions = auth.NewStaticPermissionChecker(util.DefaultRolePermissions)
+	// and no test looks up real hosts
+	server.resolver = staticResolver{}
+
 	return server
 }
 
+// activeSessions is a SessionChecker that honours every token
+type activeSessions struct{}
+
+func (activeSessions) CheckSession(ctx context.Context, payload *token.Payload) error { return nil }
+func (activeSessions) ForgetSession(sessionID uuid.UUID)                              {}
+func (activeSessions) ForgetUser(username string)                                     {}
+
+// staticResolver resolves the hosts it knows, and every other one to a public address
+type staticResolver map[string][]netip.Addr
+
+func (resolver staticResolver) LookupNetIP(ctx context.Context, network string, host string) ([]netip.Addr, error) {
+	if addrs, ok := resolver[host]; ok {
+		return addrs, nil
+	}
+	return []netip.Addr{netip.MustParseAddr("203.0.113.10")}, nil
+}
+
+// allowAllPolicy is a Policy that lets every user do everything
+type allowAllPolicy struct{}
+
+func (allowAllPolicy) CheckMoneyMovement(ctx context.Context, username string) error { return nil }
+
 func newContextWithBearerToken(t *testing.T, tokenMaker token.Maker, username string, role string, duration time.Duration, tokenType token.TokenType) context.Context {
-	accessToken, _, err := tokenMaker.CreateToken(username, role, duration, tokenType)
+	accessToken, _, err := tokenMaker.CreateToken(username, role, uuid.Nil, duration, tokenType)
 	require.NoError(t, err)
 
 	bearerToken := fmt.Sprintf("%s %s", authorizationBearer, accessToken)
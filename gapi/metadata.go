@@ -2,7 +2,13 @@ package gapi
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
+	"net/textproto"
+	"strings"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -11,11 +17,13 @@ const (
 	grpcGatewayUserAgentHeader = "grpcgateway-user-agent"
 	userAgentHeader            = "user-agent"
 	xForwardedForHeader        = "x-forwarded-for"
+	idempotencyKeyHeader       = "idempotency-key"
 )
 
 type Metadata struct {
-	UserAgent string
-	ClientIP  string
+	UserAgent      string
+	ClientIP       string
+	IdempotencyKey string
 }
 
 func (server *Server) extractMetadata(ctx context.Context) *Metadata {
@@ -31,7 +39,11 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 		}
 
 		if clientIPs := md.Get(xForwardedForHeader); len(clientIPs) > 0 {
-			mtdt.ClientIP = clientIPs[0]
+			mtdt.ClientIP = server.forwardedClientIP(strings.Join(clientIPs, ","))
+		}
+
+		if idempotencyKeys := md.Get(idempotencyKeyHeader); len(idempotencyKeys) > 0 {
+			mtdt.IdempotencyKey = idempotencyKeys[0]
 		}
 	}
 
@@ -41,3 +53,78 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 
 	return mtdt
 }
+
+// forwardedClientIP picks the client out of the X-Forwarded-For built by the gateway.
+// Its last entry is the remote address of the connection, the earlier ones are sent by the client,
+// so an entry is only believed if the one after it is a trusted proxy.
+func (server *Server) forwardedClientIP(forwardedFor string) string {
+	hops := strings.Split(forwardedFor, ",")
+
+	i := len(hops) - 1
+	for ; i > 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil || !server.isTrustedProxy(addr) {
+			break
+		}
+	}
+
+	return strings.TrimSpace(hops[i])
+}
+
+func (server *Server) isTrustedProxy(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, proxy := range server.trustedProxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies reads the addresses or CIDR ranges of the proxies allowed to set X-Forwarded-For
+func parseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		if strings.Contains(proxy, "/") {
+			prefix, err := netip.ParsePrefix(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// auditMetadata describes the request for the audit log, with actor as the user behind it
+func (server *Server) auditMetadata(ctx context.Context, actor string) db.AuditMetadata {
+	mtdt := server.extractMetadata(ctx)
+	return db.AuditMetadata{
+		Actor:     actor,
+		ClientIP:  mtdt.ClientIP,
+		UserAgent: mtdt.UserAgent,
+	}
+}
+
+// GatewayHeaderMatcher forwards the HTTP headers we read in extractMetadata to gRPC metadata,
+// on top of the ones forwarded by the default grpc-gateway matcher.
+func GatewayHeaderMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case textproto.CanonicalMIMEHeaderKey(idempotencyKeyHeader):
+		return idempotencyKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,90 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestExtractMetadataClientIP(t *testing.T) {
+	testCases := []struct {
+		name           string
+		trustedProxies []string
+		buildContext   func() context.Context
+		clientIP       string
+	}{
+		{
+			name: "RemoteAddr",
+			buildContext: func() context.Context {
+				md := metadata.Pairs(xForwardedForHeader, "203.0.113.7")
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			clientIP: "203.0.113.7",
+		},
+		{
+			name: "SpoofedByClient",
+			buildContext: func() context.Context {
+				// the client sent 1.2.3.4, the gateway appended the address it connected from
+				md := metadata.Pairs(xForwardedForHeader, "1.2.3.4, 203.0.113.7")
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			clientIP: "203.0.113.7",
+		},
+		{
+			name:           "TrustedProxy",
+			trustedProxies: []string{"10.0.0.0/8"},
+			buildContext: func() context.Context {
+				md := metadata.Pairs(xForwardedForHeader, "1.2.3.4, 203.0.113.7, 10.0.0.5")
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			clientIP: "203.0.113.7",
+		},
+		{
+			name:           "TrustedProxyChain",
+			trustedProxies: []string{"10.0.0.0/8", "192.0.2.1"},
+			buildContext: func() context.Context {
+				md := metadata.Pairs(xForwardedForHeader, "203.0.113.7, 192.0.2.1, 10.0.0.5")
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			clientIP: "203.0.113.7",
+		},
+		{
+			name: "GRPCPeer",
+			buildContext: func() context.Context {
+				md := metadata.Pairs(xForwardedForHeader, "1.2.3.4")
+				ctx := metadata.NewIncomingContext(context.Background(), md)
+				return peer.NewContext(ctx, &peer.Peer{
+					Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 4321},
+				})
+			},
+			clientIP: "203.0.113.7:4321",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, nil, nil)
+			trustedProxies, err := parseTrustedProxies(tc.trustedProxies)
+			require.NoError(t, err)
+			server.trustedProxies = trustedProxies
+
+			mtdt := server.extractMetadata(tc.buildContext())
+			require.Equal(t, tc.clientIP, mtdt.ClientIP)
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := parseTrustedProxies([]string{"10.0.0.0/8", " 192.0.2.1 ", "", "::1"})
+	require.NoError(t, err)
+	require.Len(t, proxies, 3)
+
+	_, err = parseTrustedProxies([]string{"proxy.internal"})
+	require.Error(t, err)
+}
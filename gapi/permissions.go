@@ -0,0 +1,53 @@
+package gapi
+
+import "github.com/spaghetti-lover/simplebank/util"
+
+// publicMethod marks the methods that can be called without an access token
+const publicMethod = ""
+
+// methodPermissions lists the permission needed to call each method of the SimpleBank service.
+// The handlers ask for the same permission, and for the any scope when working on another user's data.
+var methodPermissions = map[string]string{
+	"/pb.SimpleBank/CreateUser":           publicMethod,
+	"/pb.SimpleBank/LoginUser":            publicMethod,
+	"/pb.SimpleBank/VerifyTwoFactorLogin": publicMethod,
+	"/pb.SimpleBank/LogoutUser":           publicMethod,
+	"/pb.SimpleBank/RenewAccessToken":     publicMethod,
+	"/pb.SimpleBank/VerifyEmail":          publicMethod,
+	"/pb.SimpleBank/RequestPasswordReset": publicMethod,
+	"/pb.SimpleBank/ResetPassword":        publicMethod,
+
+	"/pb.SimpleBank/UpdateUser":        util.PermissionUsersUpdateOwn,
+	"/pb.SimpleBank/ResendVerifyEmail": util.PermissionUsersUpdateOwn,
+	"/pb.SimpleBank/EnableTwoFactor":   util.PermissionUsersUpdateOwn,
+	"/pb.SimpleBank/ConfirmTwoFactor":  util.PermissionUsersUpdateOwn,
+	"/pb.SimpleBank/DisableTwoFactor":  util.PermissionUsersUpdateOwn,
+
+	"/pb.SimpleBank/CreateAccount":        util.PermissionAccountsCreateOwn,
+	"/pb.SimpleBank/GetAccount":           util.PermissionAccountsReadOwn,
+	"/pb.SimpleBank/ListAccounts":         util.PermissionAccountsReadOwn,
+	"/pb.SimpleBank/ListAccountEntries":   util.PermissionAccountsReadOwn,
+	"/pb.SimpleBank/ListAccountTransfers": util.PermissionAccountsReadOwn,
+	"/pb.SimpleBank/WatchAccount":         util.PermissionAccountsReadOwn,
+	"/pb.SimpleBank/CloseAccount":         util.PermissionAccountsCloseOwn,
+	"/pb.SimpleBank/CreateTransfer":       util.PermissionTransfersCreateOwn,
+
+	"/pb.SimpleBank/ListSessions":      util.PermissionSessionsManageOwn,
+	"/pb.SimpleBank/RevokeSession":     util.PermissionSessionsManageOwn,
+	"/pb.SimpleBank/RevokeAllSessions": util.PermissionSessionsManageOwn,
+
+	"/pb.SimpleBank/CreateWebhookSubscription": util.PermissionWebhooksManageOwn,
+	"/pb.SimpleBank/ListWebhookSubscriptions":  util.PermissionWebhooksManageOwn,
+	"/pb.SimpleBank/DeleteWebhookSubscription": util.PermissionWebhooksManageOwn,
+	"/pb.SimpleBank/ListWebhookDeliveries":     util.PermissionWebhooksManageOwn,
+
+	"/pb.SimpleBank/SearchUsers":          util.PermissionUsersReadAny,
+	"/pb.SimpleBank/FreezeAccount":        util.PermissionAccountsFreezeAny,
+	"/pb.SimpleBank/UnfreezeAccount":      util.PermissionAccountsFreezeAny,
+	"/pb.SimpleBank/ReopenAccount":        util.PermissionAccountsReopenAny,
+	"/pb.SimpleBank/AdjustAccountBalance": util.PermissionAccountsAdjustAny,
+	"/pb.SimpleBank/ListRoles":            util.PermissionRolesReadAny,
+	"/pb.SimpleBank/AssignRole":           util.PermissionRolesAssignAny,
+	"/pb.SimpleBank/ListAuditEvents":      util.PermissionAuditReadAny,
+	"/pb.SimpleBank/SetExchangeRate":      util.PermissionExchangeRatesManageAny,
+}
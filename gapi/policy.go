@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Policy decides whether an authenticated user may use parts of the service
+// that go beyond what the token alone allows.
+type Policy interface {
+	// CheckMoneyMovement returns a status error if the user may not open accounts or send money
+	CheckMoneyMovement(ctx context.Context, username string) error
+}
+
+// VerifiedEmailPolicy only lets users with a verified email address move money
+type VerifiedEmailPolicy struct {
+	store db.Querier
+}
+
+// NewVerifiedEmailPolicy creates a new Policy backed by the users table
+func NewVerifiedEmailPolicy(store db.Querier) Policy {
+	return &VerifiedEmailPolicy{
+		store: store,
+	}
+}
+
+func (policy *VerifiedEmailPolicy) CheckMoneyMovement(ctx context.Context, username string) error {
+	user, err := policy.store.GetUser(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "user not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	if !user.IsEmailVerified {
+		return status.Errorf(codes.FailedPrecondition, "email address must be verified before moving money")
+	}
+
+	return nil
+}
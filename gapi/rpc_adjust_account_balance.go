@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) AdjustAccountBalance(ctx context.Context, req *pb.AdjustAccountBalanceRequest) (*pb.AdjustAccountBalanceResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionAccountsAdjustAny)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdjustAccountBalanceRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.AdjustAccountBalanceTxParams{
+		AccountID: req.GetAccountId(),
+		Amount:    req.GetAmount(),
+		Reason:    req.GetReason(),
+		Audit:     server.auditMetadata(ctx, authPayload.Username),
+	}
+
+	result, err := server.store.AdjustAccountBalanceTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account [%d] not found", req.GetAccountId())
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "insufficient funds in account [%d]", req.GetAccountId())
+		}
+		if errors.Is(err, db.ErrAccountNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to adjust account balance: %s", err)
+	}
+
+	rsp := &pb.AdjustAccountBalanceResponse{
+		Adjustment: convertAccountAdjustment(result.Adjustment),
+		Account:    convertAccount(result.Account),
+		Entry:      convertEntry(result.Entry),
+	}
+	return rsp, nil
+}
+
+func validateAdjustAccountBalanceRequest(req *pb.AdjustAccountBalanceRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateAdjustmentAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,209 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdjustAccountBalanceAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	depositor, _ := randomUser(t, util.DepositorRole)
+
+	account := randomAccount(depositor.Username)
+	amount := int64(-10)
+	reason := "reverse duplicated card fee"
+
+	testCases := []struct {
+		name          string
+		req           *pb.AdjustAccountBalanceRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdjustAccountBalanceResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.AdjustAccountBalanceRequest{
+				AccountId: account.ID,
+				Amount:    amount,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.AdjustAccountBalanceTxParams{
+					AccountID: account.ID,
+					Amount:    amount,
+					Reason:    reason,
+					Audit:     db.AuditMetadata{Actor: banker.Username},
+				}
+				adjustedAccount := account
+				adjustedAccount.Balance += amount
+				entry := randomEntry(account)
+				entry.Amount = amount
+				result := db.AdjustAccountBalanceTxResult{
+					Adjustment: db.AccountAdjustment{
+						ID:        util.RandomInt(1, 1000),
+						AccountID: account.ID,
+						EntryID:   entry.ID,
+						Amount:    amount,
+						Reason:    reason,
+						CreatedBy: banker.Username,
+					},
+					Account: adjustedAccount,
+					Entry:   entry,
+				}
+				store.EXPECT().
+					AdjustAccountBalanceTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(result, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdjustAccountBalanceResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, amount, res.GetAdjustment().GetAmount())
+				require.Equal(t, reason, res.GetAdjustment().GetReason())
+				require.Equal(t, banker.Username, res.GetAdjustment().GetCreatedBy())
+				require.Equal(t, res.GetEntry().GetId(), res.GetAdjustment().GetEntryId())
+				require.Equal(t, account.Balance+amount, res.GetAccount().GetBalance())
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			req: &pb.AdjustAccountBalanceRequest{
+				AccountId: account.ID,
+				Amount:    amount,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdjustAccountBalanceTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AdjustAccountBalanceTxResult{}, db.ErrInsufficientFunds)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdjustAccountBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req: &pb.AdjustAccountBalanceRequest{
+				AccountId: account.ID,
+				Amount:    amount,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdjustAccountBalanceTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AdjustAccountBalanceTxResult{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdjustAccountBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "MissingReason",
+			req: &pb.AdjustAccountBalanceRequest{
+				AccountId: account.ID,
+				Amount:    amount,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdjustAccountBalanceTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdjustAccountBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ZeroAmount",
+			req: &pb.AdjustAccountBalanceRequest{
+				AccountId: account.ID,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdjustAccountBalanceTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdjustAccountBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req: &pb.AdjustAccountBalanceRequest{
+				AccountId: account.ID,
+				Amount:    1000,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdjustAccountBalanceTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdjustAccountBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.AdjustAccountBalance(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) AssignRole(ctx context.Context, req *pb.AssignRoleRequest) (*pb.AssignRoleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionRolesAssignAny)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAssignRoleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	txResult, err := server.store.UpdateUserRoleTx(ctx, db.UpdateUserRoleTxParams{
+		Username: req.GetUsername(),
+		Role:     req.GetRole(),
+		Audit:    server.auditMetadata(ctx, authPayload.Username),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			return nil, status.Errorf(codes.NotFound, "role not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to assign role: %s", err)
+	}
+
+	// the sessions were blocked so that new tokens carry the new role
+	server.sessionChecker.ForgetUser(txResult.User.Username)
+
+	rsp := &pb.AssignRoleResponse{
+		User: convertUser(txResult.User),
+	}
+	return rsp, nil
+}
+
+func validateAssignRoleRequest(req *pb.AssignRoleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if err := val.ValidateRole(req.GetRole()); err != nil {
+		violations = append(violations, fieldViolation("role", err))
+	}
+
+	return violations
+}
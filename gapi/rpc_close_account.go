@@ -0,0 +1,113 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionAccountsCloseOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCloseAccountRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	// the owner of a frozen account cannot lift the freeze by closing it
+	if account.Owner != authPayload.Username || account.Status == util.AccountStatusFrozen {
+		err = server.checkPermission(ctx, authPayload, util.PermissionAccountsCloseAny)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	arg := db.CloseAccountTxParams{
+		AccountID:    req.GetAccountId(),
+		FromStatus:   account.Status,
+		ExchangeRate: db.IdentityExchangeRate(),
+		Reason:       req.GetReason(),
+		Audit:        server.auditMetadata(ctx, authPayload.Username),
+	}
+
+	if req.SweepToAccountId != nil {
+		// sweeping the balance moves money like a transfer does
+		err = server.policy.CheckMoneyMovement(ctx, authPayload.Username)
+		if err != nil {
+			return nil, err
+		}
+
+		sweepAccount, err := server.getAccount(ctx, req.GetSweepToAccountId())
+		if err != nil {
+			return nil, err
+		}
+
+		if sweepAccount.Owner != account.Owner {
+			err := fmt.Errorf("account [%d] doesn't belong to %s", sweepAccount.ID, account.Owner)
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("sweep_to_account_id", err)})
+		}
+
+		if sweepAccount.Currency != account.Currency {
+			arg.ExchangeRate, err = server.getExchangeRate(ctx, account.Currency, sweepAccount.Currency)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		arg.SweepToAccountID = sweepAccount.ID
+	}
+
+	result, err := server.store.CloseAccountTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		if errors.Is(err, db.ErrAccountStatusTransition) ||
+			errors.Is(err, db.ErrAccountBalanceNotZero) ||
+			errors.Is(err, db.ErrAccountNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, db.ErrInvalidConvertedAmount) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("sweep_to_account_id", err)})
+		}
+		return nil, status.Errorf(codes.Internal, "failed to close account: %s", err)
+	}
+
+	rsp := &pb.CloseAccountResponse{
+		Account: convertAccount(result.Account),
+	}
+	if result.Sweep != nil {
+		rsp.Sweep = convertTransfer(result.Sweep.Transfer)
+	}
+	return rsp, nil
+}
+
+func validateCloseAccountRequest(req *pb.CloseAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateAccountStatusRequest(req.GetAccountId(), req.GetReason())
+
+	if req.SweepToAccountId != nil {
+		if err := val.ValidateAccountId(req.GetSweepToAccountId()); err != nil {
+			violations = append(violations, fieldViolation("sweep_to_account_id", err))
+		} else if req.GetSweepToAccountId() == req.GetAccountId() {
+			violations = append(violations, fieldViolation("sweep_to_account_id", fmt.Errorf("must be different from account_id")))
+		}
+	}
+
+	return violations
+}
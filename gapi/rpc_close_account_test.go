@@ -0,0 +1,427 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCloseAccountAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	otherUser, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account3 := randomAccount(user.Username)
+	otherAccount := randomAccount(otherUser.Username)
+
+	account1.ID, account2.ID, account3.ID, otherAccount.ID = 1, 2, 3, 4
+	account1.Currency = util.USD
+	account2.Currency = util.USD
+	account3.Currency = util.EUR
+	otherAccount.Currency = util.USD
+
+	frozenAccount1 := account1
+	frozenAccount1.Balance = 0
+	frozenAccount1.Status = util.AccountStatusFrozen
+
+	closedAccount1 := account1
+	closedAccount1.Balance = 0
+	closedAccount1.Status = util.AccountStatusClosed
+
+	reason := "moving to another bank"
+
+	testCases := []struct {
+		name string
+		req  *pb.CloseAccountRequest
+		// verifiedEmailPolicy replaces the policy that allows everything
+		verifiedEmailPolicy bool
+		buildStubs          func(store *mockdb.MockStore)
+		buildContext        func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse       func(t *testing.T, res *pb.CloseAccountResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CloseAccountRequest{
+				AccountId: account1.ID,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				arg := db.CloseAccountTxParams{
+					AccountID:    account1.ID,
+					FromStatus:   util.AccountStatusActive,
+					ExchangeRate: db.IdentityExchangeRate(),
+					Reason:       reason,
+					Audit:        db.AuditMetadata{Actor: user.Username},
+				}
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CloseAccountTxResult{Account: closedAccount1}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.AccountStatusClosed, res.GetAccount().GetStatus())
+				require.Nil(t, res.GetSweep())
+			},
+		},
+		{
+			name: "Sweep",
+			req: &pb.CloseAccountRequest{
+				AccountId:        account1.ID,
+				SweepToAccountId: proto.Int64(account2.ID),
+				Reason:           reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CloseAccountTxParams{
+					AccountID:        account1.ID,
+					FromStatus:       util.AccountStatusActive,
+					SweepToAccountID: account2.ID,
+					ExchangeRate:     db.IdentityExchangeRate(),
+					Reason:           reason,
+					Audit:            db.AuditMetadata{Actor: user.Username},
+				}
+				result := db.CloseAccountTxResult{
+					Account: closedAccount1,
+					Sweep: &db.TransferTxResult{
+						Transfer: db.Transfer{
+							ID:            util.RandomInt(1, 1000),
+							FromAccountID: account1.ID,
+							ToAccountID:   account2.ID,
+							Amount:        account1.Balance,
+							ToAmount:      account1.Balance,
+							ExchangeRate:  db.IdentityExchangeRate(),
+						},
+					},
+				}
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.AccountStatusClosed, res.GetAccount().GetStatus())
+				require.Equal(t, account2.ID, res.GetSweep().GetToAccountId())
+				require.Equal(t, account1.Balance, res.GetSweep().GetAmount())
+			},
+		},
+		{
+			name: "SweepCrossCurrency",
+			req: &pb.CloseAccountRequest{
+				AccountId:        account1.ID,
+				SweepToAccountId: proto.Int64(account3.ID),
+				Reason:           reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account3.ID)).Times(1).Return(account3, nil)
+
+				rateArg := db.GetExchangeRateParams{
+					FromCurrency: util.USD,
+					ToCurrency:   util.EUR,
+				}
+				store.EXPECT().GetExchangeRate(gomock.Any(), gomock.Eq(rateArg)).Times(1).
+					Return(db.ExchangeRate{FromCurrency: util.USD, ToCurrency: util.EUR, Rate: exchangeRate("0.9")}, nil)
+
+				arg := db.CloseAccountTxParams{
+					AccountID:        account1.ID,
+					FromStatus:       util.AccountStatusActive,
+					SweepToAccountID: account3.ID,
+					ExchangeRate:     exchangeRate("0.9"),
+					Reason:           reason,
+					Audit:            db.AuditMetadata{Actor: user.Username},
+				}
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.CloseAccountTxResult{Account: closedAccount1}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "SweepToOtherOwner",
+			req: &pb.CloseAccountRequest{
+				AccountId:        account1.ID,
+				SweepToAccountId: proto.Int64(otherAccount.ID),
+				Reason:           reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(otherAccount.ID)).Times(1).Return(otherAccount, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "BalanceNotZero",
+			req: &pb.CloseAccountRequest{
+				AccountId: account1.ID,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				err := fmt.Errorf("account [%d] balance is %d: %w", account1.ID, account1.Balance, db.ErrAccountBalanceNotZero)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CloseAccountTxResult{}, err)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "AlreadyClosed",
+			req: &pb.CloseAccountRequest{
+				AccountId: account1.ID,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(closedAccount1, nil)
+
+				err := fmt.Errorf("account [%d] is already closed: %w", account1.ID, db.ErrAccountStatusTransition)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CloseAccountTxResult{}, err)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "NotOwner",
+			req: &pb.CloseAccountRequest{
+				AccountId: account1.ID,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, otherUser.Username, otherUser.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "BankerClosesAnyAccount",
+			req: &pb.CloseAccountRequest{
+				AccountId: account1.ID,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				arg := db.CloseAccountTxParams{
+					AccountID:    account1.ID,
+					FromStatus:   util.AccountStatusActive,
+					ExchangeRate: db.IdentityExchangeRate(),
+					Reason:       reason,
+					Audit:        db.AuditMetadata{Actor: banker.Username},
+				}
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.CloseAccountTxResult{Account: closedAccount1}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.AccountStatusClosed, res.GetAccount().GetStatus())
+			},
+		},
+		{
+			name: "OwnerClosesFrozenAccount",
+			req: &pb.CloseAccountRequest{
+				AccountId: account1.ID,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(frozenAccount1, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "BankerClosesFrozenAccount",
+			req: &pb.CloseAccountRequest{
+				AccountId: account1.ID,
+				Reason:    reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(frozenAccount1, nil)
+
+				arg := db.CloseAccountTxParams{
+					AccountID:    account1.ID,
+					FromStatus:   util.AccountStatusFrozen,
+					ExchangeRate: db.IdentityExchangeRate(),
+					Reason:       reason,
+					Audit:        db.AuditMetadata{Actor: banker.Username},
+				}
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.CloseAccountTxResult{Account: closedAccount1}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.AccountStatusClosed, res.GetAccount().GetStatus())
+			},
+		},
+		{
+			name: "SweepEmailNotVerified",
+			req: &pb.CloseAccountRequest{
+				AccountId:        account1.ID,
+				SweepToAccountId: proto.Int64(account2.ID),
+				Reason:           reason,
+			},
+			verifiedEmailPolicy: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(0)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "SweepToSameAccount",
+			req: &pb.CloseAccountRequest{
+				AccountId:        account1.ID,
+				SweepToAccountId: proto.Int64(account1.ID),
+				Reason:           reason,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+			if tc.verifiedEmailPolicy {
+				server.policy = NewVerifiedEmailPolicy(store)
+			}
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CloseAccount(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestReopenAccountAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	depositor, _ := randomUser(t, util.DepositorRole)
+	account := randomAccount(depositor.Username)
+	reason := "closed by mistake"
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	server := newTestServer(t, store, nil)
+
+	// the owner cannot reopen their own account
+	store.EXPECT().ReopenAccountTx(gomock.Any(), gomock.Any()).Times(0)
+	ctx := newContextWithBearerToken(t, server.tokenMaker, depositor.Username, depositor.Role, time.Minute, token.TokenTypeAccessToken)
+	_, err := server.ReopenAccount(ctx, &pb.ReopenAccountRequest{AccountId: account.ID, Reason: reason})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	arg := db.ReopenAccountTxParams{
+		AccountID: account.ID,
+		Reason:    reason,
+		Audit:     db.AuditMetadata{Actor: banker.Username},
+	}
+	store.EXPECT().ReopenAccountTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.ReopenAccountTxResult{Account: account}, nil)
+	ctx = newContextWithBearerToken(t, server.tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+	res, err := server.ReopenAccount(ctx, &pb.ReopenAccountRequest{AccountId: account.ID, Reason: reason})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountStatusActive, res.GetAccount().GetStatus())
+
+	// an account that is not closed cannot be reopened
+	store.EXPECT().ReopenAccountTx(gomock.Any(), gomock.Any()).Times(1).Return(db.ReopenAccountTxResult{}, db.ErrAccountStatusTransition)
+	_, err = server.ReopenAccount(ctx, &pb.ReopenAccountRequest{AccountId: account.ID, Reason: reason})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,88 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spaghetti-lover/simplebank/auth"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ConfirmTwoFactor(ctx context.Context, req *pb.ConfirmTwoFactorRequest) (*pb.ConfirmTwoFactorResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionUsersUpdateOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateConfirmTwoFactorRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	user, err := server.store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	if user.IsTotpEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := server.decryptTOTPSecret(user)
+	if err != nil {
+		return nil, err
+	}
+
+	step, ok := auth.ValidateTOTP(secret, req.GetCode(), time.Now())
+	if !ok {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("code", errors.New("does not match the TOTP secret")),
+		})
+	}
+
+	recoveryCodes, err := auth.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate recovery codes: %s", err)
+	}
+
+	hashedCodes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashedCodes[i] = auth.HashRecoveryCode(code)
+	}
+
+	txResult, err := server.store.EnableUserTotpTx(ctx, db.EnableUserTotpTxParams{
+		EnableUserTotpParams: db.EnableUserTotpParams{
+			Username:      user.Username,
+			TotpLastStep:  step,
+			RecoveryCodes: hashedCodes,
+		},
+		Audit: server.auditMetadata(ctx, authPayload.Username),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to enable two-factor authentication: %s", err)
+	}
+
+	rsp := &pb.ConfirmTwoFactorResponse{
+		User:          convertUser(txResult.User),
+		RecoveryCodes: recoveryCodes,
+	}
+	return rsp, nil
+}
+
+func validateConfirmTwoFactorRequest(req *pb.ConfirmTwoFactorRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateTOTPCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,128 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spaghetti-lover/simplebank/auth"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConfirmTwoFactorAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+
+	testCases := []struct {
+		name          string
+		buildRequest  func(t *testing.T, secret string) *pb.ConfirmTwoFactorRequest
+		buildStubs    func(store *mockdb.MockStore, user db.User)
+		checkResponse func(t *testing.T, res *pb.ConfirmTwoFactorResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildRequest: func(t *testing.T, secret string) *pb.ConfirmTwoFactorRequest {
+				code, err := auth.TOTPCode(secret, time.Now())
+				require.NoError(t, err)
+				return &pb.ConfirmTwoFactorRequest{Code: code}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					EnableUserTotpTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.EnableUserTotpTxParams) (db.EnableUserTotpTxResult, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.NotZero(t, arg.TotpLastStep)
+						require.Len(t, arg.RecoveryCodes, 10)
+						require.Equal(t, user.Username, arg.Audit.Actor)
+
+						user.IsTotpEnabled = true
+						user.RecoveryCodes = arg.RecoveryCodes
+						return db.EnableUserTotpTxResult{User: user}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmTwoFactorResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetUser().GetIsTwoFactorEnabled())
+				require.Len(t, res.GetRecoveryCodes(), 10)
+			},
+		},
+		{
+			name: "WrongCode",
+			buildRequest: func(t *testing.T, secret string) *pb.ConfirmTwoFactorRequest {
+				code, err := auth.TOTPCode(secret, time.Now().Add(-time.Hour))
+				require.NoError(t, err)
+				return &pb.ConfirmTwoFactorRequest{Code: code}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().EnableUserTotpTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmTwoFactorResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NotSetUp",
+			buildRequest: func(t *testing.T, secret string) *pb.ConfirmTwoFactorRequest {
+				return &pb.ConfirmTwoFactorRequest{Code: "123456"}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				user.TotpSecret.Valid = false
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().EnableUserTotpTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmTwoFactorResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InvalidCode",
+			buildRequest: func(t *testing.T, secret string) *pb.ConfirmTwoFactorRequest {
+				return &pb.ConfirmTwoFactorRequest{Code: "12ab"}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmTwoFactorResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			server := newTestServer(t, store, nil)
+			pendingUser, secret := withTOTPSecret(t, server, user)
+
+			tc.buildStubs(store, pendingUser)
+
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			res, err := server.ConfirmTwoFactor(ctx, tc.buildRequest(t, secret))
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/exchange"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionTransfersCreateOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	mtdt := server.extractMetadata(ctx)
+
+	violations := validateCreateTransferRequest(req)
+	if mtdt.IdempotencyKey != "" {
+		if err := val.ValidateIdempotencyKey(mtdt.IdempotencyKey); err != nil {
+			violations = append(violations, fieldViolation(idempotencyKeyHeader, err))
+		}
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.validAccount(ctx, "from_account_id", req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	err = server.policy.CheckMoneyMovement(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	toAccount, err := server.getAccount(ctx, req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	var result db.TransferTxResult
+	if toAccount.Currency == fromAccount.Currency {
+		arg := db.TransferTxParams{
+			FromAccountID:  req.GetFromAccountId(),
+			ToAccountID:    req.GetToAccountId(),
+			Amount:         req.GetAmount(),
+			IdempotencyKey: mtdt.IdempotencyKey,
+			Audit:          server.auditMetadata(ctx, authPayload.Username),
+		}
+
+		result, err = server.store.TransferTx(ctx, arg)
+	} else {
+		var rate pgtype.Numeric
+		rate, err = server.getExchangeRate(ctx, fromAccount.Currency, toAccount.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		arg := db.CrossCurrencyTransferTxParams{
+			FromAccountID:  req.GetFromAccountId(),
+			ToAccountID:    req.GetToAccountId(),
+			Amount:         req.GetAmount(),
+			ExchangeRate:   rate,
+			IdempotencyKey: mtdt.IdempotencyKey,
+			Audit:          server.auditMetadata(ctx, authPayload.Username),
+		}
+
+		result, err = server.store.CrossCurrencyTransferTx(ctx, arg)
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrIdempotencyKeyReused) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "insufficient funds in account [%d]", fromAccount.ID)
+		}
+		if errors.Is(err, db.ErrAccountNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, db.ErrInvalidConvertedAmount) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("amount", err)})
+		}
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to transfer money: %s", err)
+	}
+
+	rsp := &pb.CreateTransferResponse{
+		Transfer:    convertTransfer(result.Transfer),
+		FromAccount: convertAccount(result.FromAccount),
+		ToAccount:   convertAccount(result.ToAccount),
+		FromEntry:   convertEntry(result.FromEntry),
+		ToEntry:     convertEntry(result.ToEntry),
+	}
+	return rsp, nil
+}
+
+func (server *Server) getAccount(ctx context.Context, accountID int64) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return account, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		return account, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	return account, nil
+}
+
+func (server *Server) getExchangeRate(ctx context.Context, fromCurrency string, toCurrency string) (pgtype.Numeric, error) {
+	rate, err := server.rateProvider.GetRate(ctx, fromCurrency, toCurrency)
+	if err != nil {
+		if errors.Is(err, exchange.ErrRateNotFound) {
+			return rate, status.Errorf(codes.FailedPrecondition, "cannot convert %s to %s", fromCurrency, toCurrency)
+		}
+		return rate, status.Errorf(codes.Internal, "failed to get exchange rate: %s", err)
+	}
+
+	return rate, nil
+}
+
+func (server *Server) validAccount(ctx context.Context, field string, accountID int64, currency string) (db.Account, error) {
+	account, err := server.getAccount(ctx, accountID)
+	if err != nil {
+		return account, err
+	}
+
+	if account.Currency != currency {
+		err := fmt.Errorf("account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
+		return account, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation(field, err)})
+	}
+
+	return account, nil
+}
+
+func validateCreateTransferRequest(req *pb.CreateTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountId(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateAccountId(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	} else if req.GetToAccountId() == req.GetFromAccountId() {
+		violations = append(violations, fieldViolation("to_account_id", fmt.Errorf("must be different from from_account_id")))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	return violations
+}
@@ -4,10 +4,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/hibiken/asynq"
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"github.com/spaghetti-lover/simplebank/pb"
-	"github.com/spaghetti-lover/simplebank/util"
 	"github.com/spaghetti-lover/simplebank/val"
 	"github.com/spaghetti-lover/simplebank/worker"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -21,7 +19,7 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		return nil, invalidArgumentError(violations)
 	}
 
-	hashedPassword, err := util.HashPassword(req.GetPassword())
+	hashedPassword, err := server.passwordHasher.Hash(req.GetPassword())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
 	}
@@ -33,18 +31,23 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 			FullName:       req.GetFullName(),
 			Email:          req.GetEmail(),
 		},
-		AfterCreate: func(user db.User) error {
+		AfterCreate: func(user db.User) ([]db.CreateOutboxTaskParams, error) {
 			taskPayload := &worker.PayloadSendVerifyEmail{
 				Username: user.Username,
 			}
-			opts := []asynq.Option{
-				asynq.MaxRetry(10),
-				asynq.ProcessIn(10 * time.Second),
-				asynq.Queue(worker.QueueCritical),
+			opts := worker.OutboxTaskOptions{
+				Queue:     worker.QueueCritical,
+				MaxRetry:  10,
+				ProcessIn: 10 * time.Second,
 			}
 
-			return server.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
+			task, err := worker.NewOutboxTask(worker.TaskSendVerifyEmail, taskPayload, opts)
+			if err != nil {
+				return nil, err
+			}
+			return []db.CreateOutboxTaskParams{task}, nil
 		},
+		Audit: server.auditMetadata(ctx, req.GetUsername()),
 	}
 
 	txResult, err := server.store.CreateUserTx(ctx, arg)
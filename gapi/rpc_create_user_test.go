@@ -1,6 +1,7 @@
 package gapi
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
@@ -23,6 +24,7 @@ type eqCreateUserTxParamsMatcher struct {
 	arg      db.CreateUserTxParams
 	password string
 	user     db.User
+	tasks    []db.CreateOutboxTaskParams
 }
 
 func (expected eqCreateUserTxParamsMatcher) Matches(x interface{}) bool {
@@ -41,16 +43,36 @@ func (expected eqCreateUserTxParamsMatcher) Matches(x interface{}) bool {
 		return false
 	}
 
-	err = actualArg.AfterCreate(expected.user)
-	return err == nil
+	tasks, err := actualArg.AfterCreate(expected.user)
+	return err == nil && eqOutboxTasks(expected.tasks, tasks)
 }
 
 func (e eqCreateUserTxParamsMatcher) String() string {
 	return fmt.Sprintf("matches arg %v and password %v", e.arg, e.password)
 }
 
-func EqCreateUserTxParams(arg db.CreateUserTxParams, password string, user db.User) gomock.Matcher {
-	return eqCreateUserTxParamsMatcher{arg, password, user}
+func EqCreateUserTxParams(arg db.CreateUserTxParams, password string, user db.User, tasks []db.CreateOutboxTaskParams) gomock.Matcher {
+	return eqCreateUserTxParamsMatcher{arg, password, user, tasks}
+}
+
+// eqOutboxTasks compares the type and payload of the tasks, the time they are processed at depends on the clock
+func eqOutboxTasks(expected []db.CreateOutboxTaskParams, actual []db.CreateOutboxTaskParams) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+
+	for i := range expected {
+		if expected[i].TaskType != actual[i].TaskType || !bytes.Equal(expected[i].Payload, actual[i].Payload) {
+			return false
+		}
+	}
+	return true
+}
+
+func newTestOutboxTask(t *testing.T, taskType string, payload any) db.CreateOutboxTaskParams {
+	task, err := worker.NewOutboxTask(taskType, payload, worker.OutboxTaskOptions{})
+	require.NoError(t, err)
+	return task
 }
 
 func randomUser(t *testing.T, role string) (user db.User, password string) {
@@ -93,18 +115,15 @@ func TestCreateUserAPI(t *testing.T) {
 						Email:    user.Email,
 					},
 				}
+				tasks := []db.CreateOutboxTaskParams{
+					newTestOutboxTask(t, worker.TaskSendVerifyEmail, &worker.PayloadSendVerifyEmail{
+						Username: user.Username,
+					}),
+				}
 				store.EXPECT().
-					CreateUserTx(gomock.Any(), EqCreateUserTxParams(arg, password, user)).
+					CreateUserTx(gomock.Any(), EqCreateUserTxParams(arg, password, user, tasks)).
 					Times(1).
 					Return(db.CreateUserTxResult{User: user}, nil)
-
-				taskPayload := &worker.PayloadSendVerifyEmail{
-					Username: user.Username,
-				}
-				taskDistributor.EXPECT().
-					DistributeTaskSendVerifyEmail(gomock.Any(), taskPayload, gomock.Any()).
-					Times(1).
-					Return(nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
 				require.NoError(t, err)
@@ -128,10 +147,6 @@ func TestCreateUserAPI(t *testing.T) {
 					CreateUserTx(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.CreateUserTxResult{}, sql.ErrConnDone)
-
-				taskDistributor.EXPECT().
-					DistributeTaskSendVerifyEmail(gomock.Any(), gomock.Any(), gomock.Any()).
-					Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
 				require.Error(t, err)
@@ -153,10 +168,6 @@ func TestCreateUserAPI(t *testing.T) {
 					CreateUserTx(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.CreateUserTxResult{}, db.ErrUniqueViolation)
-
-				taskDistributor.EXPECT().
-					DistributeTaskSendVerifyEmail(gomock.Any(), gomock.Any(), gomock.Any()).
-					Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
 				require.Error(t, err)
@@ -177,10 +188,6 @@ func TestCreateUserAPI(t *testing.T) {
 				store.EXPECT().
 					CreateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
-
-				taskDistributor.EXPECT().
-					DistributeTaskSendVerifyEmail(gomock.Any(), gomock.Any(), gomock.Any()).
-					Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
 				require.Error(t, err)
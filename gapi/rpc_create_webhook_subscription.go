@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/auth"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.CreateWebhookSubscriptionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionWebhooksManageOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateWebhookSubscriptionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.checkWebhookURL(ctx, req.GetUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	if server.webhookCipher == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "webhooks are not configured")
+	}
+
+	secret, err := auth.GenerateWebhookSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %s", err)
+	}
+
+	encryptedSecret, err := server.webhookCipher.Encrypt(secret)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encrypt webhook secret: %s", err)
+	}
+
+	subscription, err := server.store.CreateWebhookSubscription(ctx, db.CreateWebhookSubscriptionParams{
+		Owner:           authPayload.Username,
+		Url:             req.GetUrl(),
+		EventTypes:      req.GetEventTypes(),
+		EncryptedSecret: encryptedSecret,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook subscription: %s", err)
+	}
+
+	rsp := &pb.CreateWebhookSubscriptionResponse{
+		Subscription: convertWebhookSubscription(subscription),
+		Secret:       secret,
+	}
+	return rsp, nil
+}
+
+func validateCreateWebhookSubscriptionRequest(req *pb.CreateWebhookSubscriptionRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateWebhookURL(req.GetUrl()); err != nil {
+		violations = append(violations, fieldViolation("url", err))
+	}
+
+	if err := val.ValidateEventTypes(req.GetEventTypes()); err != nil {
+		violations = append(violations, fieldViolation("event_types", err))
+	}
+
+	return violations
+}
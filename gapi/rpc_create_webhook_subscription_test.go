@@ -0,0 +1,256 @@
+package gapi
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func randomWebhookSubscription(owner string) db.WebhookSubscription {
+	return db.WebhookSubscription{
+		ID:              util.RandomInt(1, 1000),
+		Owner:           owner,
+		Url:             "https://example.com/hooks/" + util.RandomString(8),
+		EventTypes:      []string{util.EventTransferCreated, util.EventAccountCreated},
+		EncryptedSecret: util.RandomString(32),
+		CreatedAt:       time.Now().Truncate(time.Microsecond),
+	}
+}
+
+func TestCreateWebhookSubscriptionAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	subscription := randomWebhookSubscription(user.Username)
+
+	// the encrypted secret the handler stored, checked against the secret it returned
+	var encryptedSecret string
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateWebhookSubscriptionRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, server *Server, res *pb.CreateWebhookSubscriptionResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        subscription.Url,
+				EventTypes: subscription.EventTypes,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateWebhookSubscription(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateWebhookSubscriptionParams) (db.WebhookSubscription, error) {
+						require.Equal(t, user.Username, arg.Owner)
+						require.Equal(t, subscription.Url, arg.Url)
+						require.Equal(t, subscription.EventTypes, arg.EventTypes)
+						encryptedSecret = arg.EncryptedSecret
+						return subscription, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.CreateWebhookSubscriptionResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, subscription.ID, res.GetSubscription().GetId())
+				require.Equal(t, user.Username, res.GetSubscription().GetOwner())
+				require.Equal(t, subscription.EventTypes, res.GetSubscription().GetEventTypes())
+
+				secret, err := server.webhookCipher.Decrypt(encryptedSecret)
+				require.NoError(t, err)
+				require.Equal(t, res.GetSecret(), secret)
+			},
+		},
+		{
+			name: "InvalidURL",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        "ftp://example.com/hooks",
+				EventTypes: subscription.EventTypes,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.CreateWebhookSubscriptionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "LoopbackAddress",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        "http://127.0.0.1:8080/hooks",
+				EventTypes: subscription.EventTypes,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.CreateWebhookSubscriptionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "MetadataAddress",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        "http://[::ffff:169.254.169.254]/latest",
+				EventTypes: subscription.EventTypes,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.CreateWebhookSubscriptionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ResolvesToPrivateAddress",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        "https://intranet.example.com/hooks",
+				EventTypes: subscription.EventTypes,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.CreateWebhookSubscriptionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "UnknownEventType",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        subscription.Url,
+				EventTypes: []string{"account.deleted"},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.CreateWebhookSubscriptionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        subscription.Url,
+				EventTypes: subscription.EventTypes,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateWebhookSubscription(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.WebhookSubscription{}, db.ErrUniqueViolation)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.CreateWebhookSubscriptionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        subscription.Url,
+				EventTypes: subscription.EventTypes,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.CreateWebhookSubscriptionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+			server.resolver = staticResolver{
+				"intranet.example.com": {netip.MustParseAddr("10.0.0.1")},
+			}
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateWebhookSubscription(ctx, tc.req)
+			tc.checkResponse(t, server, res, err)
+		})
+	}
+}
+
+func TestCreateWebhookSubscriptionNotConfigured(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+	server.webhookCipher = nil
+
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+	_, err := server.CreateWebhookSubscription(ctx, &pb.CreateWebhookSubscriptionRequest{
+		Url:        "https://example.com/hooks",
+		EventTypes: []string{util.EventTransferCreated},
+	})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+}
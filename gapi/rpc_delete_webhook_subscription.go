@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionWebhooksManageOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeleteWebhookSubscriptionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	subscription, err := server.getManageableWebhookSubscription(ctx, authPayload, req.GetSubscriptionId())
+	if err != nil {
+		return nil, err
+	}
+
+	// events already in the queue for this subscription are dropped by the worker
+	err = server.store.DeleteWebhookSubscription(ctx, subscription.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook subscription: %s", err)
+	}
+
+	return &pb.DeleteWebhookSubscriptionResponse{}, nil
+}
+
+func validateDeleteWebhookSubscriptionRequest(req *pb.DeleteWebhookSubscriptionRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateSubscriptionId(req.GetSubscriptionId()); err != nil {
+		violations = append(violations, fieldViolation("subscription_id", err))
+	}
+
+	return violations
+}
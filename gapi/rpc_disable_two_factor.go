@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DisableTwoFactor(ctx context.Context, req *pb.DisableTwoFactorRequest) (*pb.DisableTwoFactorResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionUsersUpdateOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDisableTwoFactorRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	user, err := server.store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	err = server.passwordHasher.Check(req.GetPassword(), user.HashedPassword)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "incorrect password")
+	}
+
+	if !user.IsTotpEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+
+	err = server.verifySecondFactor(ctx, user, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	txResult, err := server.store.DisableUserTotpTx(ctx, db.DisableUserTotpTxParams{
+		Username: user.Username,
+		Audit:    server.auditMetadata(ctx, authPayload.Username),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to disable two-factor authentication: %s", err)
+	}
+
+	rsp := &pb.DisableTwoFactorResponse{
+		User: convertUser(txResult.User),
+	}
+	return rsp, nil
+}
+
+func validateDisableTwoFactorRequest(req *pb.DisableTwoFactorRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePassword(req.GetPassword()); err != nil {
+		violations = append(violations, fieldViolation("password", err))
+	}
+
+	if err := val.ValidateTwoFactorCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	return violations
+}
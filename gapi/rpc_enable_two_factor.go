@@ -0,0 +1,83 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/simplebank/auth"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) EnableTwoFactor(ctx context.Context, req *pb.EnableTwoFactorRequest) (*pb.EnableTwoFactorResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionUsersUpdateOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateEnableTwoFactorRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if server.secretCipher == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not configured")
+	}
+
+	user, err := server.store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	err = server.passwordHasher.Check(req.GetPassword(), user.HashedPassword)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "incorrect password")
+	}
+
+	if user.IsTotpEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate TOTP secret: %s", err)
+	}
+
+	encryptedSecret, err := server.secretCipher.Encrypt(secret)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encrypt TOTP secret: %s", err)
+	}
+
+	_, err = server.store.SetUserTotpSecret(ctx, db.SetUserTotpSecretParams{
+		Username:   user.Username,
+		TotpSecret: encryptedSecret,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to save TOTP secret: %s", err)
+	}
+
+	rsp := &pb.EnableTwoFactorResponse{
+		Secret:          secret,
+		ProvisioningUri: auth.TOTPProvisioningURI(server.config.TOTPIssuer, user.Username, secret),
+	}
+	return rsp, nil
+}
+
+func validateEnableTwoFactorRequest(req *pb.EnableTwoFactorRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePassword(req.GetPassword()); err != nil {
+		violations = append(violations, fieldViolation("password", err))
+	}
+
+	return violations
+}
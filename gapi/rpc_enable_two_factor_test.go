@@ -0,0 +1,141 @@
+package gapi
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/simplebank/auth"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// withTOTPSecret stores a new TOTP secret on the user the way EnableTwoFactor does
+func withTOTPSecret(t *testing.T, server *Server, user db.User) (db.User, string) {
+	secret, err := auth.GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	encryptedSecret, err := server.secretCipher.Encrypt(secret)
+	require.NoError(t, err)
+
+	user.TotpSecret = pgtype.Text{String: encryptedSecret, Valid: true}
+	return user, secret
+}
+
+func TestEnableTwoFactorAPI(t *testing.T) {
+	user, password := randomUser(t, util.DepositorRole)
+
+	testCases := []struct {
+		name          string
+		req           *pb.EnableTwoFactorRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, server *Server, res *pb.EnableTwoFactorResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.EnableTwoFactorRequest{Password: password},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					SetUserTotpSecret(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.SetUserTotpSecretParams) (db.User, error) {
+						require.Equal(t, user.Username, arg.Username)
+						return user, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.EnableTwoFactorResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetSecret())
+
+				uri, err := url.Parse(res.GetProvisioningUri())
+				require.NoError(t, err)
+				require.Equal(t, "otpauth", uri.Scheme)
+				require.Equal(t, res.GetSecret(), uri.Query().Get("secret"))
+				require.Equal(t, "Simple Bank", uri.Query().Get("issuer"))
+			},
+		},
+		{
+			name: "IncorrectPassword",
+			req:  &pb.EnableTwoFactorRequest{Password: "incorrect"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().SetUserTotpSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.EnableTwoFactorResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "AlreadyEnabled",
+			req:  &pb.EnableTwoFactorRequest{Password: password},
+			buildStubs: func(store *mockdb.MockStore) {
+				enabledUser := user
+				enabledUser.IsTotpEnabled = true
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(enabledUser, nil)
+				store.EXPECT().SetUserTotpSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.EnableTwoFactorResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.EnableTwoFactorRequest{Password: password},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.EnableTwoFactorResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.EnableTwoFactor(ctx, tc.req)
+			tc.checkResponse(t, server, res, err)
+		})
+	}
+}
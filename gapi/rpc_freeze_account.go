@@ -0,0 +1,100 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionAccountsFreezeAny)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAccountStatusRequest(req.GetAccountId(), req.GetReason())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.changeAccountStatus(ctx, authPayload, req.GetAccountId(), util.AccountStatusActive, util.AccountStatusFrozen, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.FreezeAccountResponse{
+		Account: convertAccount(account),
+	}
+	return rsp, nil
+}
+
+func (server *Server) UnfreezeAccount(ctx context.Context, req *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionAccountsFreezeAny)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAccountStatusRequest(req.GetAccountId(), req.GetReason())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.changeAccountStatus(ctx, authPayload, req.GetAccountId(), util.AccountStatusFrozen, util.AccountStatusActive, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.UnfreezeAccountResponse{
+		Account: convertAccount(account),
+	}
+	return rsp, nil
+}
+
+// changeAccountStatus moves the account from one status to another on behalf of the authenticated user
+func (server *Server) changeAccountStatus(
+	ctx context.Context,
+	authPayload *token.Payload,
+	accountID int64,
+	fromStatus string,
+	toStatus string,
+	reason string,
+) (db.Account, error) {
+	result, err := server.store.ChangeAccountStatusTx(ctx, db.ChangeAccountStatusTxParams{
+		AccountID:  accountID,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		Reason:     reason,
+		Audit:      server.auditMetadata(ctx, authPayload.Username),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return result.Account, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		if errors.Is(err, db.ErrAccountStatusTransition) {
+			return result.Account, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return result.Account, status.Errorf(codes.Internal, "failed to change account status: %s", err)
+	}
+
+	return result.Account, nil
+}
+
+func validateAccountStatusRequest(accountID int64, reason string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountId(accountID); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateReason(reason); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
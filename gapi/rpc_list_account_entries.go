@@ -0,0 +1,86 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccountEntries(ctx context.Context, req *pb.ListAccountEntriesRequest) (*pb.ListAccountEntriesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionAccountsReadOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListAccountEntriesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getReadableAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, endTime := statementPeriod(req.GetStartTime(), req.GetEndTime())
+	incoming, outgoing := directionFilter(req.GetDirection())
+
+	// already checked by the validation above
+	pageToken, _ := util.DecodePageToken(req.GetPageToken())
+
+	arg := db.ListEntriesParams{
+		AccountID:      account.ID,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Incoming:       incoming,
+		Outgoing:       outgoing,
+		AfterCreatedAt: pageToken.CreatedAt,
+		AfterID:        pageToken.ID,
+		Limit:          req.GetPageSize() + 1,
+	}
+
+	entries, err := server.store.ListEntries(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %s", err)
+	}
+
+	var nextPageToken string
+	if len(entries) > int(req.GetPageSize()) {
+		entries = entries[:req.GetPageSize()]
+		last := entries[len(entries)-1]
+		nextPageToken = util.EncodePageToken(last.CreatedAt, last.ID)
+	}
+
+	rsp := &pb.ListAccountEntriesResponse{
+		Entries:       make([]*pb.Entry, len(entries)),
+		NextPageToken: nextPageToken,
+	}
+	for i, entry := range entries {
+		rsp.Entries[i] = convertEntry(entry)
+	}
+	return rsp, nil
+}
+
+func validateListAccountEntriesRequest(req *pb.ListAccountEntriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	violations = append(violations, validateStatementFilters(req.GetStartTime(), req.GetEndTime(), req.GetDirection())...)
+
+	return violations
+}
@@ -0,0 +1,86 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccountTransfers(ctx context.Context, req *pb.ListAccountTransfersRequest) (*pb.ListAccountTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionAccountsReadOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListAccountTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getReadableAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, endTime := statementPeriod(req.GetStartTime(), req.GetEndTime())
+	incoming, outgoing := directionFilter(req.GetDirection())
+
+	// already checked by the validation above
+	pageToken, _ := util.DecodePageToken(req.GetPageToken())
+
+	arg := db.ListTransfersParams{
+		AccountID:      account.ID,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Incoming:       incoming,
+		Outgoing:       outgoing,
+		AfterCreatedAt: pageToken.CreatedAt,
+		AfterID:        pageToken.ID,
+		Limit:          req.GetPageSize() + 1,
+	}
+
+	transfers, err := server.store.ListTransfers(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %s", err)
+	}
+
+	var nextPageToken string
+	if len(transfers) > int(req.GetPageSize()) {
+		transfers = transfers[:req.GetPageSize()]
+		last := transfers[len(transfers)-1]
+		nextPageToken = util.EncodePageToken(last.CreatedAt, last.ID)
+	}
+
+	rsp := &pb.ListAccountTransfersResponse{
+		Transfers:     make([]*pb.Transfer, len(transfers)),
+		NextPageToken: nextPageToken,
+	}
+	for i, transfer := range transfers {
+		rsp.Transfers[i] = convertTransfer(transfer)
+	}
+	return rsp, nil
+}
+
+func validateListAccountTransfersRequest(req *pb.ListAccountTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	violations = append(violations, validateStatementFilters(req.GetStartTime(), req.GetEndTime(), req.GetDirection())...)
+
+	return violations
+}
@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionAccountsReadOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListAccountsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	owner := authPayload.Username
+	if req.Owner != nil && req.GetOwner() != authPayload.Username {
+		err = server.checkPermission(ctx, authPayload, util.PermissionAccountsReadAny)
+		if err != nil {
+			return nil, err
+		}
+		owner = req.GetOwner()
+	}
+
+	// already checked by the validation above
+	pageToken, _ := util.DecodePageToken(req.GetPageToken())
+
+	// fetch one more row than asked for to know whether there is a next page
+	arg := db.ListAccountsParams{
+		Owner:          owner,
+		AfterCreatedAt: pageToken.CreatedAt,
+		AfterID:        pageToken.ID,
+		Limit:          req.GetPageSize() + 1,
+	}
+
+	accounts, err := server.store.ListAccounts(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list accounts: %s", err)
+	}
+
+	var nextPageToken string
+	if len(accounts) > int(req.GetPageSize()) {
+		accounts = accounts[:req.GetPageSize()]
+		last := accounts[len(accounts)-1]
+		nextPageToken = util.EncodePageToken(last.CreatedAt, last.ID)
+	}
+
+	rsp := &pb.ListAccountsResponse{
+		Accounts:      make([]*pb.Account, len(accounts)),
+		NextPageToken: nextPageToken,
+	}
+	for i, account := range accounts {
+		rsp.Accounts[i] = convertAccount(account)
+	}
+	return rsp, nil
+}
+
+func validateListAccountsRequest(req *pb.ListAccountsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	if req.Owner != nil {
+		if err := val.ValidateUsername(req.GetOwner()); err != nil {
+			violations = append(violations, fieldViolation("owner", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,117 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	_, err := server.authorizeUser(ctx, util.PermissionAuditReadAny)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListAuditEventsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	startTime, endTime := statementPeriod(req.GetStartTime(), req.GetEndTime())
+
+	// already checked by the validation above
+	pageToken, _ := util.DecodePageToken(req.GetPageToken())
+
+	arg := db.ListAuditEventsParams{
+		Actor: pgtype.Text{
+			String: req.GetActor(),
+			Valid:  req.Actor != nil,
+		},
+		Action: pgtype.Text{
+			String: req.GetAction(),
+			Valid:  req.Action != nil,
+		},
+		TargetType: pgtype.Text{
+			String: req.GetTargetType(),
+			Valid:  req.TargetType != nil,
+		},
+		TargetID: pgtype.Text{
+			String: req.GetTargetId(),
+			Valid:  req.TargetId != nil,
+		},
+		StartTime:      startTime,
+		EndTime:        endTime,
+		AfterCreatedAt: pageToken.CreatedAt,
+		AfterID:        pageToken.ID,
+		Limit:          req.GetPageSize() + 1,
+	}
+
+	events, err := server.store.ListAuditEvents(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit events: %s", err)
+	}
+
+	var nextPageToken string
+	if len(events) > int(req.GetPageSize()) {
+		events = events[:req.GetPageSize()]
+		last := events[len(events)-1]
+		nextPageToken = util.EncodePageToken(last.CreatedAt, last.ID)
+	}
+
+	rsp := &pb.ListAuditEventsResponse{
+		Events:        make([]*pb.AuditEvent, len(events)),
+		NextPageToken: nextPageToken,
+	}
+	for i, event := range events {
+		rsp.Events[i], err = convertAuditEvent(event)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert audit event: %s", err)
+		}
+	}
+	return rsp, nil
+}
+
+func validateListAuditEventsRequest(req *pb.ListAuditEventsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Actor != nil {
+		if err := val.ValidateUsername(req.GetActor()); err != nil {
+			violations = append(violations, fieldViolation("actor", err))
+		}
+	}
+
+	if req.Action != nil {
+		if err := val.ValidateAuditAction(req.GetAction()); err != nil {
+			violations = append(violations, fieldViolation("action", err))
+		}
+	}
+
+	if req.TargetType != nil {
+		if err := val.ValidateAuditTargetType(req.GetTargetType()); err != nil {
+			violations = append(violations, fieldViolation("target_type", err))
+		}
+	}
+
+	if req.TargetId != nil {
+		if err := val.ValidateAuditTargetId(req.GetTargetId()); err != nil {
+			violations = append(violations, fieldViolation("target_id", err))
+		}
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	violations = append(violations, validateStatementPeriod(req.GetStartTime(), req.GetEndTime())...)
+
+	return violations
+}
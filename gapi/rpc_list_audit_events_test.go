@@ -0,0 +1,253 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListAuditEventsAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	depositor, _ := randomUser(t, util.DepositorRole)
+	account := randomAccount(depositor.Username)
+
+	n := 5
+	events := make([]db.AuditEvent, n)
+	for i := 0; i < n; i++ {
+		events[i] = randomAuditEvent(banker.Username, account)
+	}
+
+	action := util.AuditActionAccountAdjust
+	targetType := util.AuditTargetAccount
+	targetID := fmt.Sprint(account.ID)
+	unknownAction := "account.delete"
+	unknownTargetType := "entry"
+
+	startTime := time.Now().UTC().Add(-24 * time.Hour).Truncate(time.Second)
+	endTime := time.Now().UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListAuditEventsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListAuditEventsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListAuditEventsRequest{
+				Actor:      &banker.Username,
+				Action:     &action,
+				TargetType: &targetType,
+				TargetId:   &targetID,
+				StartTime:  timestamppb.New(startTime),
+				EndTime:    timestamppb.New(endTime),
+				PageSize:   int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListAuditEventsParams{
+					Actor:      pgtype.Text{String: banker.Username, Valid: true},
+					Action:     pgtype.Text{String: util.AuditActionAccountAdjust, Valid: true},
+					TargetType: pgtype.Text{String: util.AuditTargetAccount, Valid: true},
+					TargetID:   pgtype.Text{String: fmt.Sprint(account.ID), Valid: true},
+					StartTime:  startTime,
+					EndTime:    endTime,
+					Limit:      int32(n) + 1,
+				}
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(events, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEvents(), n)
+				require.Empty(t, res.GetNextPageToken())
+				for i, event := range res.GetEvents() {
+					require.Equal(t, events[i].ID, event.GetId())
+					require.Equal(t, events[i].Action, event.GetAction())
+					require.Equal(t, float64(account.Balance), event.GetBefore().GetFields()["balance"].GetNumberValue())
+					require.Equal(t, float64(account.Balance+10), event.GetAfter().GetFields()["balance"].GetNumberValue())
+				}
+			},
+		},
+		{
+			name: "NextPage",
+			req: &pb.ListAuditEventsRequest{
+				PageSize:  int32(n - 1),
+				PageToken: util.EncodePageToken(events[0].CreatedAt, events[0].ID),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.ListAuditEventsParams) ([]db.AuditEvent, error) {
+						require.False(t, arg.Actor.Valid)
+						require.False(t, arg.Action.Valid)
+						require.False(t, arg.TargetType.Valid)
+						require.False(t, arg.TargetID.Valid)
+						require.True(t, arg.StartTime.IsZero())
+						require.WithinDuration(t, time.Now(), arg.EndTime, time.Second)
+						require.Equal(t, events[0].ID, arg.AfterID)
+						require.True(t, events[0].CreatedAt.Equal(arg.AfterCreatedAt))
+						require.Equal(t, int32(n), arg.Limit)
+						return events, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEvents(), n-1)
+				last := events[n-2]
+				require.Equal(t, util.EncodePageToken(last.CreatedAt, last.ID), res.GetNextPageToken())
+			},
+		},
+		{
+			name: "InvalidFilters",
+			req: &pb.ListAuditEventsRequest{
+				Action:     &unknownAction,
+				TargetType: &unknownTargetType,
+				PageSize:   int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAuditEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidTimeRange",
+			req: &pb.ListAuditEventsRequest{
+				StartTime: timestamppb.New(endTime),
+				EndTime:   timestamppb.New(startTime),
+				PageSize:  int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAuditEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.ListAuditEventsRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.AuditEvent{}, db.ErrUniqueViolation)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req: &pb.ListAuditEventsRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAuditEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.ListAuditEventsRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAuditEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListAuditEvents(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func randomAuditEvent(actor string, account db.Account) db.AuditEvent {
+	return db.AuditEvent{
+		ID:         util.RandomInt(1, 1000),
+		Actor:      actor,
+		Action:     util.AuditActionAccountAdjust,
+		TargetType: util.AuditTargetAccount,
+		TargetID:   fmt.Sprint(account.ID),
+		Before:     []byte(fmt.Sprintf(`{"balance": %d}`, account.Balance)),
+		After:      []byte(fmt.Sprintf(`{"balance": %d}`, account.Balance+10)),
+		ClientIp:   "192.0.2.1",
+		UserAgent:  "grpc-go",
+		CreatedAt:  time.Now().Truncate(time.Microsecond),
+	}
+}
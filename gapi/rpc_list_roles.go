@@ -0,0 +1,40 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListRoles(ctx context.Context, req *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	_, err := server.authorizeUser(ctx, util.PermissionRolesReadAny)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := server.store.ListRoles(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list roles: %s", err)
+	}
+
+	rolePermissions, err := server.store.ListRolePermissions(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list role permissions: %s", err)
+	}
+
+	permissions := make(map[string][]string)
+	for _, rolePermission := range rolePermissions {
+		permissions[rolePermission.Role] = append(permissions[rolePermission.Role], rolePermission.Permission)
+	}
+
+	rsp := &pb.ListRolesResponse{
+		Roles: make([]*pb.Role, 0, len(roles)),
+	}
+	for _, role := range roles {
+		rsp.Roles = append(rsp.Roles, convertRole(role, permissions[role.Name]))
+	}
+	return rsp, nil
+}
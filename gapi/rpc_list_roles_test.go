@@ -0,0 +1,124 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListRolesAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	depositor, _ := randomUser(t, util.DepositorRole)
+
+	roles := []db.Role{
+		{Name: util.BankerRole, Description: util.RandomString(10)},
+		{Name: util.DepositorRole, Description: util.RandomString(10)},
+	}
+	rolePermissions := []db.RolePermission{
+		{Role: util.BankerRole, Permission: util.PermissionAccountsReadAny},
+		{Role: util.BankerRole, Permission: util.PermissionRolesAssignAny},
+		{Role: util.DepositorRole, Permission: util.PermissionAccountsReadOwn},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListRolesResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRoles(gomock.Any()).Times(1).Return(roles, nil)
+				store.EXPECT().ListRolePermissions(gomock.Any()).Times(1).Return(rolePermissions, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRolesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetRoles(), len(roles))
+
+				require.Equal(t, util.BankerRole, res.GetRoles()[0].GetName())
+				require.Equal(t, roles[0].Description, res.GetRoles()[0].GetDescription())
+				require.Equal(t, []string{util.PermissionAccountsReadAny, util.PermissionRolesAssignAny}, res.GetRoles()[0].GetPermissions())
+
+				require.Equal(t, util.DepositorRole, res.GetRoles()[1].GetName())
+				require.Equal(t, []string{util.PermissionAccountsReadOwn}, res.GetRoles()[1].GetPermissions())
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRoles(gomock.Any()).Times(1).Return([]db.Role{}, db.ErrUniqueViolation)
+				store.EXPECT().ListRolePermissions(gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRolesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRoles(gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRolesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRoles(gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRolesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListRoles(ctx, &pb.ListRolesRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
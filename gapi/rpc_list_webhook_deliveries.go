@@ -0,0 +1,77 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionWebhooksManageOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListWebhookDeliveriesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	subscription, err := server.getManageableWebhookSubscription(ctx, authPayload, req.GetSubscriptionId())
+	if err != nil {
+		return nil, err
+	}
+
+	// already checked by the validation above
+	pageToken, _ := util.DecodePageToken(req.GetPageToken())
+
+	arg := db.ListWebhookDeliveriesParams{
+		SubscriptionID: subscription.ID,
+		AfterCreatedAt: pageToken.CreatedAt,
+		AfterID:        pageToken.ID,
+		Limit:          req.GetPageSize() + 1,
+	}
+
+	deliveries, err := server.store.ListWebhookDeliveries(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %s", err)
+	}
+
+	var nextPageToken string
+	if len(deliveries) > int(req.GetPageSize()) {
+		deliveries = deliveries[:req.GetPageSize()]
+		last := deliveries[len(deliveries)-1]
+		nextPageToken = util.EncodePageToken(last.CreatedAt, last.ID)
+	}
+
+	rsp := &pb.ListWebhookDeliveriesResponse{
+		Deliveries:    make([]*pb.WebhookDelivery, len(deliveries)),
+		NextPageToken: nextPageToken,
+	}
+	for i, delivery := range deliveries {
+		rsp.Deliveries[i] = convertWebhookDelivery(delivery)
+	}
+	return rsp, nil
+}
+
+func validateListWebhookDeliveriesRequest(req *pb.ListWebhookDeliveriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateSubscriptionId(req.GetSubscriptionId()); err != nil {
+		violations = append(violations, fieldViolation("subscription_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
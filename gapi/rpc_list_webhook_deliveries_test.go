@@ -0,0 +1,203 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListWebhookDeliveriesAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+	subscription := randomWebhookSubscription(user.Username)
+
+	n := 5
+	deliveries := make([]db.WebhookDelivery, n)
+	for i := 0; i < n; i++ {
+		deliveries[i] = randomWebhookDelivery(subscription)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListWebhookDeliveriesRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListWebhookDeliveriesResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListWebhookDeliveriesRequest{
+				SubscriptionId: subscription.ID,
+				PageSize:       int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetWebhookSubscription(gomock.Any(), gomock.Eq(subscription.ID)).
+					Times(1).
+					Return(subscription, nil)
+				arg := db.ListWebhookDeliveriesParams{
+					SubscriptionID: subscription.ID,
+					Limit:          int32(n) + 1,
+				}
+				store.EXPECT().
+					ListWebhookDeliveries(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(deliveries, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookDeliveriesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetDeliveries(), n)
+				require.Empty(t, res.GetNextPageToken())
+				for i, delivery := range res.GetDeliveries() {
+					require.Equal(t, deliveries[i].ID, delivery.GetId())
+					require.Equal(t, deliveries[i].EventID.String(), delivery.GetEventId())
+					require.Equal(t, deliveries[i].StatusCode, delivery.GetStatusCode())
+				}
+			},
+		},
+		{
+			name: "NextPage",
+			req: &pb.ListWebhookDeliveriesRequest{
+				SubscriptionId: subscription.ID,
+				PageSize:       int32(n - 1),
+				PageToken:      util.EncodePageToken(deliveries[0].CreatedAt, deliveries[0].ID),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetWebhookSubscription(gomock.Any(), gomock.Eq(subscription.ID)).
+					Times(1).
+					Return(subscription, nil)
+				store.EXPECT().
+					ListWebhookDeliveries(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.ListWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
+						require.Equal(t, deliveries[0].ID, arg.AfterID)
+						require.True(t, deliveries[0].CreatedAt.Equal(arg.AfterCreatedAt))
+						require.Equal(t, int32(n), arg.Limit)
+						return deliveries, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookDeliveriesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetDeliveries(), n-1)
+				last := deliveries[n-2]
+				require.Equal(t, util.EncodePageToken(last.CreatedAt, last.ID), res.GetNextPageToken())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req: &pb.ListWebhookDeliveriesRequest{
+				SubscriptionId: subscription.ID,
+				PageSize:       int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetWebhookSubscription(gomock.Any(), gomock.Eq(subscription.ID)).
+					Times(1).
+					Return(subscription, nil)
+				store.EXPECT().ListWebhookDeliveries(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookDeliveriesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "InvalidPageSize",
+			req: &pb.ListWebhookDeliveriesRequest{
+				SubscriptionId: subscription.ID,
+				PageSize:       0,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListWebhookDeliveries(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookDeliveriesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.ListWebhookDeliveriesRequest{
+				SubscriptionId: subscription.ID,
+				PageSize:       int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetWebhookSubscription(gomock.Any(), gomock.Eq(subscription.ID)).
+					Times(1).
+					Return(subscription, nil)
+				store.EXPECT().
+					ListWebhookDeliveries(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.WebhookDelivery{}, db.ErrUniqueViolation)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookDeliveriesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListWebhookDeliveries(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func randomWebhookDelivery(subscription db.WebhookSubscription) db.WebhookDelivery {
+	return db.WebhookDelivery{
+		ID:             util.RandomInt(1, 1000),
+		SubscriptionID: subscription.ID,
+		EventID:        uuid.New(),
+		EventType:      util.EventTransferCreated,
+		Attempt:        1,
+		StatusCode:     200,
+		CreatedAt:      time.Now().Truncate(time.Microsecond),
+	}
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListWebhookSubscriptions(ctx context.Context, req *pb.ListWebhookSubscriptionsRequest) (*pb.ListWebhookSubscriptionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionWebhooksManageOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListWebhookSubscriptionsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	owner := authPayload.Username
+	if req.Owner != nil && req.GetOwner() != authPayload.Username {
+		err = server.checkPermission(ctx, authPayload, util.PermissionWebhooksManageAny)
+		if err != nil {
+			return nil, err
+		}
+		owner = req.GetOwner()
+	}
+
+	subscriptions, err := server.store.ListWebhookSubscriptions(ctx, owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook subscriptions: %s", err)
+	}
+
+	rsp := &pb.ListWebhookSubscriptionsResponse{
+		Subscriptions: make([]*pb.WebhookSubscription, len(subscriptions)),
+	}
+	for i, subscription := range subscriptions {
+		rsp.Subscriptions[i] = convertWebhookSubscription(subscription)
+	}
+	return rsp, nil
+}
+
+func validateListWebhookSubscriptionsRequest(req *pb.ListWebhookSubscriptionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Owner != nil {
+		if err := val.ValidateUsername(req.GetOwner()); err != nil {
+			violations = append(violations, fieldViolation("owner", err))
+		}
+	}
+
+	return violations
+}
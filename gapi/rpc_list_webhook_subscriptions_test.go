@@ -0,0 +1,144 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListWebhookSubscriptionsAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	n := 3
+	subscriptions := make([]db.WebhookSubscription, n)
+	for i := 0; i < n; i++ {
+		subscriptions[i] = randomWebhookSubscription(user.Username)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListWebhookSubscriptionsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListWebhookSubscriptionsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListWebhookSubscriptionsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListWebhookSubscriptions(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(subscriptions, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookSubscriptionsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetSubscriptions(), n)
+				for i, subscription := range res.GetSubscriptions() {
+					require.Equal(t, subscriptions[i].ID, subscription.GetId())
+					require.Equal(t, subscriptions[i].Url, subscription.GetUrl())
+				}
+			},
+		},
+		{
+			name: "BankerListsOtherOwner",
+			req:  &pb.ListWebhookSubscriptionsRequest{Owner: &user.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListWebhookSubscriptions(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(subscriptions, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookSubscriptionsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetSubscriptions(), n)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.ListWebhookSubscriptionsRequest{Owner: &user.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListWebhookSubscriptions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookSubscriptionsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListWebhookSubscriptionsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListWebhookSubscriptions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.WebhookSubscription{}, db.ErrUniqueViolation)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookSubscriptionsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.ListWebhookSubscriptionsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListWebhookSubscriptions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ListWebhookSubscriptionsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListWebhookSubscriptions(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
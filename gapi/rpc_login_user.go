@@ -3,50 +3,186 @@ package gapi
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/simplebank/auth"
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"github.com/spaghetti-lover/simplebank/pb"
 	"github.com/spaghetti-lover/simplebank/token"
-	"github.com/spaghetti-lover/simplebank/util"
 	"github.com/spaghetti-lover/simplebank/val"
+	"github.com/spaghetti-lover/simplebank/worker"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// errInvalidCredentials does not tell whether the username or the password was wrong
+var errInvalidCredentials = status.Error(codes.Unauthenticated, "invalid username or password")
+
 func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
 	violations := validateLoginUserRequest(req)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
 
+	mtdt := server.extractMetadata(ctx)
+	attempt, err := server.beginLoginAttempt(ctx, req.GetUsername(), mtdt.ClientIP)
+	if err != nil {
+		return nil, err
+	}
+	defer server.releaseLoginAttempt(ctx, attempt)
+
 	user, err := server.store.GetUser(ctx, req.GetUsername())
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "user not found")
+			// unknown usernames take as long and fail the same way as wrong passwords,
+			// so they cannot be told apart
+			dummyHash, err := server.dummyHash()
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
+			}
+			_ = server.passwordHasher.Check(req.GetPassword(), dummyHash)
+			if err := server.recordLoginFailure(ctx, attempt, req.GetUsername(), nil); err != nil {
+				return nil, err
+			}
+			return nil, errInvalidCredentials
 		}
 		return nil, status.Errorf(codes.Internal, "failed to find user")
 	}
 
-	err = util.CheckPassword(req.Password, user.HashedPassword)
+	err = server.passwordHasher.Check(req.GetPassword(), user.HashedPassword)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "incorrect password")
+		if err := server.recordLoginFailure(ctx, attempt, user.Username, &user); err != nil {
+			return nil, err
+		}
+		return nil, errInvalidCredentials
 	}
 
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
-		user.Username,
-		user.Role,
-		server.config.AccessTokenDuration,
-		token.TokenTypeAccessToken,
-	)
+	if server.passwordHasher.NeedsRehash(user.HashedPassword) {
+		server.rehashPassword(ctx, user, req.GetPassword())
+	}
+
+	if user.IsTotpEnabled {
+		mfaToken, mfaPayload, err := server.tokenMaker.CreateToken(
+			user.Username,
+			user.Role,
+			uuid.Nil,
+			server.config.MFATokenDuration,
+			token.TokenTypeMFAToken,
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create mfa token")
+		}
+
+		// the user stays unknown to the client until the second factor passes
+		rsp := &pb.LoginUserResponse{
+			MfaRequired:       true,
+			MfaToken:          mfaToken,
+			MfaTokenExpiresAt: timestamppb.New(mfaPayload.ExpiredAt),
+		}
+		return rsp, nil
+	}
+
+	err = attempt.Succeed(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create access token")
+		return nil, status.Errorf(codes.Internal, "failed to reset login attempts: %s", err)
+	}
+
+	return server.createLoginSession(ctx, user)
+}
+
+// rehashPassword replaces a hash made with an outdated algorithm or parameters,
+// while the plain password is at hand. Login goes on if it fails.
+func (server *Server) rehashPassword(ctx context.Context, user db.User, password string) {
+	hashedPassword, err := server.passwordHasher.Hash(password)
+	if err != nil {
+		log.Error().Err(err).Str("username", user.Username).Msg("failed to rehash password")
+		return
+	}
+
+	// the password may have been changed meanwhile, it must not be overwritten
+	_, err = server.store.RehashUserPassword(ctx, db.RehashUserPasswordParams{
+		Username:          user.Username,
+		OldHashedPassword: user.HashedPassword,
+		NewHashedPassword: hashedPassword,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("username", user.Username).Msg("failed to store rehashed password")
+	}
+}
+
+// beginLoginAttempt counts a login attempt before the credentials are checked,
+// and refuses it if the username or the client IP is locked out
+func (server *Server) beginLoginAttempt(ctx context.Context, username string, clientIP string) (*auth.LoginAttempt, error) {
+	attempt, err := server.loginGuard.Begin(ctx, username, clientIP)
+	if err != nil {
+		if errors.Is(err, auth.ErrLoginLocked) {
+			return nil, status.Errorf(codes.ResourceExhausted, "too many failed login attempts, try again later")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check login attempts: %s", err)
+	}
+	return attempt, nil
+}
+
+// releaseLoginAttempt takes back a login attempt that did not fail,
+// e.g. because it waits for the second factor or broke off on an internal error
+func (server *Server) releaseLoginAttempt(ctx context.Context, attempt *auth.LoginAttempt) {
+	err := attempt.Release(context.WithoutCancel(ctx))
+	if err != nil {
+		log.Error().Err(err).Msg("failed to release login attempt")
+	}
+}
+
+// recordLoginFailure counts a failed login with the username, which user is nil if it does not exist.
+// The failure and any lockout it causes go to the audit log, and the user is emailed when they are locked out.
+func (server *Server) recordLoginFailure(ctx context.Context, attempt *auth.LoginAttempt, username string, user *db.User) error {
+	locked, err := attempt.Fail(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record login attempt: %s", err)
+	}
+
+	// the actor is unknown until the login succeeds
+	audit := server.auditMetadata(ctx, "")
+	err = server.store.RecordLoginFailureTx(ctx, db.RecordLoginFailureTxParams{
+		Username: username,
+		Locked:   locked,
+		Audit:    audit,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record login attempt: %s", err)
+	}
+
+	if locked && user != nil {
+		taskPayload := &worker.PayloadSendLoginLockoutEmail{
+			Username:    user.Username,
+			ClientIP:    audit.ClientIP,
+			LockedUntil: time.Now().Add(server.config.LoginLockoutDuration),
+		}
+		opts := []asynq.Option{
+			asynq.MaxRetry(10),
+			asynq.Queue(worker.QueueCritical),
+		}
+
+		// the lockout holds even if the user cannot be told about it
+		err = server.taskDistributor.DistributeTaskSendLoginLockoutEmail(ctx, taskPayload, opts...)
+		if err != nil {
+			log.Error().Err(err).Str("username", user.Username).Msg("failed to distribute login lockout email")
+		}
 	}
 
+	return nil
+}
+
+// createLoginSession issues the tokens of a new session once the user is fully authenticated
+func (server *Server) createLoginSession(ctx context.Context, user db.User) (*pb.LoginUserResponse, error) {
 	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
 		user.Role,
+		uuid.Nil,
 		server.config.RefreshTokenDuration,
 		token.TokenTypeRefreshToken,
 	)
@@ -54,15 +190,29 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		return nil, status.Errorf(codes.Internal, "failed to create refresh token")
 	}
 
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
+		user.Username,
+		user.Role,
+		refreshPayload.SessionID,
+		server.config.AccessTokenDuration,
+		token.TokenTypeAccessToken,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token")
+	}
+
 	mtdt := server.extractMetadata(ctx)
-	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
-		ID:           refreshPayload.ID,
-		Username:     user.Username,
-		RefreshToken: refreshToken,
-		UserAgent:    mtdt.UserAgent,
-		ClientIp:     mtdt.ClientIP,
-		IsBlocked:    false,
-		ExpiresAt:    refreshPayload.ExpiredAt,
+	txResult, err := server.store.CreateSessionTx(ctx, db.CreateSessionTxParams{
+		CreateSessionParams: db.CreateSessionParams{
+			ID:           refreshPayload.SessionID,
+			Username:     user.Username,
+			RefreshToken: refreshToken,
+			UserAgent:    mtdt.UserAgent,
+			ClientIp:     mtdt.ClientIP,
+			IsBlocked:    false,
+			ExpiresAt:    refreshPayload.ExpiredAt,
+		},
+		Audit: server.auditMetadata(ctx, user.Username),
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create session")
@@ -70,7 +220,7 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 
 	rsp := &pb.LoginUserResponse{
 		User:                  convertUser(user),
-		SessionId:             session.ID.String(),
+		SessionId:             txResult.Session.ID.String(),
 		AccessToken:           accessToken,
 		RefreshToken:          refreshToken,
 		AccessTokenExpiresAt:  timestamppb.New(accessPayload.ExpiredAt),
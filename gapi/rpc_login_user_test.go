@@ -0,0 +1,250 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/spaghetti-lover/simplebank/auth"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/worker"
+	mockwk "github.com/spaghetti-lover/simplebank/worker/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoginUserAPI(t *testing.T) {
+	user, password := randomUser(t, util.DepositorRole)
+
+	twoFactorUser := user
+	twoFactorUser.IsTotpEnabled = true
+
+	testCases := []struct {
+		name          string
+		req           *pb.LoginUserRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, server *Server, res *pb.LoginUserResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateSessionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateSessionTxParams) (db.CreateSessionTxResult, error) {
+						require.Equal(t, user.Username, arg.Audit.Actor)
+						session := db.Session{ID: arg.ID, Username: arg.Username}
+						return db.CreateSessionTxResult{Session: session}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetMfaRequired())
+				require.Empty(t, res.GetMfaToken())
+
+				payload, err := server.tokenMaker.VerifyToken(res.GetAccessToken(), token.TokenTypeAccessToken)
+				require.NoError(t, err)
+				require.Equal(t, res.GetSessionId(), payload.SessionID.String())
+			},
+		},
+		{
+			name: "TwoFactorRequired",
+			req: &pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(twoFactorUser, nil)
+				store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetMfaRequired())
+				require.Nil(t, res.GetUser())
+				require.Empty(t, res.GetAccessToken())
+				require.Empty(t, res.GetRefreshToken())
+				require.Empty(t, res.GetSessionId())
+
+				payload, err := server.tokenMaker.VerifyToken(res.GetMfaToken(), token.TokenTypeMFAToken)
+				require.NoError(t, err)
+				require.Equal(t, user.Username, payload.Username)
+
+				// the challenge cannot be used as an access token
+				_, err = server.tokenMaker.VerifyToken(res.GetMfaToken(), token.TokenTypeAccessToken)
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "IncorrectPassword",
+			req: &pb.LoginUserRequest{
+				Username: user.Username,
+				Password: "incorrect",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(twoFactorUser, nil)
+				store.EXPECT().
+					RecordLoginFailureTx(gomock.Any(), gomock.Eq(db.RecordLoginFailureTxParams{Username: user.Username})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+				require.Equal(t, errInvalidCredentials.Error(), err.Error())
+			},
+		},
+		{
+			name: "UserNotFound",
+			req: &pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(db.User{}, db.ErrRecordNotFound)
+				// the failure is audited with the username that was tried
+				store.EXPECT().
+					RecordLoginFailureTx(gomock.Any(), gomock.Eq(db.RecordLoginFailureTxParams{Username: user.Username})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+				// the same error as a wrong password, so usernames cannot be probed
+				require.Equal(t, errInvalidCredentials.Error(), err.Error())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			res, err := server.LoginUser(context.Background(), tc.req)
+			tc.checkResponse(t, server, res, err)
+		})
+	}
+}
+
+func TestLoginUserLockout(t *testing.T) {
+	user, password := randomUser(t, util.DepositorRole)
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	taskCtrl := gomock.NewController(t)
+	defer taskCtrl.Finish()
+	taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+	server := newTestServer(t, store, taskDistributor)
+	server.config.LoginLockoutDuration = time.Minute
+	server.loginGuard = auth.NewLoginGuard(auth.NewMemoryLoginAttemptCounter(), auth.LoginGuardConfig{
+		MaxFailures:     2,
+		LockoutDuration: time.Minute,
+	})
+
+	// the second failure locks the account and tells its owner, and both go to the audit log
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(2).Return(user, nil)
+	gomock.InOrder(
+		store.EXPECT().
+			RecordLoginFailureTx(gomock.Any(), gomock.Eq(db.RecordLoginFailureTxParams{Username: user.Username})).
+			Times(1).
+			Return(nil),
+		store.EXPECT().
+			RecordLoginFailureTx(gomock.Any(), gomock.Eq(db.RecordLoginFailureTxParams{Username: user.Username, Locked: true})).
+			Times(1).
+			Return(nil),
+	)
+	taskDistributor.EXPECT().
+		DistributeTaskSendLoginLockoutEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, payload *worker.PayloadSendLoginLockoutEmail, opts ...asynq.Option) error {
+			require.Equal(t, user.Username, payload.Username)
+			require.WithinDuration(t, time.Now().Add(time.Minute), payload.LockedUntil, time.Second)
+			return nil
+		})
+
+	for i := 0; i < 2; i++ {
+		_, err := server.LoginUser(context.Background(), &pb.LoginUserRequest{
+			Username: user.Username,
+			Password: "incorrect",
+		})
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.Unauthenticated, st.Code())
+	}
+
+	// even the right password is refused until the lockout ends
+	store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+	_, err := server.LoginUser(context.Background(), &pb.LoginUserRequest{
+		Username: user.Username,
+		Password: password,
+	})
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+}
+
+func TestLoginUserRehashPassword(t *testing.T) {
+	user, password := randomUser(t, util.DepositorRole)
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	server := newTestServer(t, store, nil)
+	server.passwordHasher = util.NewArgon2idHasher(util.Argon2idParams{
+		Memory:      1024,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+
+	// the bcrypt hash is replaced by an argon2id one of the same password
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().
+		RehashUserPassword(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.RehashUserPasswordParams) (int64, error) {
+			require.Equal(t, user.Username, arg.Username)
+			require.Equal(t, user.HashedPassword, arg.OldHashedPassword)
+			require.False(t, server.passwordHasher.NeedsRehash(arg.NewHashedPassword))
+			require.NoError(t, server.passwordHasher.Check(password, arg.NewHashedPassword))
+			return 1, nil
+		})
+	store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(1)
+
+	res, err := server.LoginUser(context.Background(), &pb.LoginUserRequest{
+		Username: user.Username,
+		Password: password,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
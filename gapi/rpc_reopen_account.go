@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ReopenAccount(ctx context.Context, req *pb.ReopenAccountRequest) (*pb.ReopenAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionAccountsReopenAny)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAccountStatusRequest(req.GetAccountId(), req.GetReason())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.ReopenAccountTx(ctx, db.ReopenAccountTxParams{
+		AccountID: req.GetAccountId(),
+		Reason:    req.GetReason(),
+		Audit:     server.auditMetadata(ctx, authPayload.Username),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account [%d] not found", req.GetAccountId())
+		}
+		if errors.Is(err, db.ErrAccountStatusTransition) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reopen account: %s", err)
+	}
+
+	rsp := &pb.ReopenAccountResponse{
+		Account: convertAccount(result.Account),
+	}
+	return rsp, nil
+}
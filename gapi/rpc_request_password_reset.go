@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/val"
+	"github.com/spaghetti-lover/simplebank/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	violations := validateRequestPasswordResetRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	user, err := server.store.GetUserByEmail(ctx, req.GetEmail())
+	if err != nil {
+		// do not tell whether the email is registered
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return &pb.RequestPasswordResetResponse{}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find user: %s", err)
+	}
+
+	// an email that was sent recently is still valid, and the answer stays the same
+	// so that it does not tell whether the email is registered either
+	passwordReset, err := server.store.GetLatestPasswordReset(ctx, user.Username)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get password reset: %s", err)
+	}
+	if err == nil && time.Since(passwordReset.CreatedAt) < server.config.PasswordResetInterval {
+		return &pb.RequestPasswordResetResponse{}, nil
+	}
+
+	taskPayload := &worker.PayloadSendResetPasswordEmail{
+		Username: user.Username,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+	if server.config.PasswordResetInterval >= time.Second {
+		// covers the requests made before the queued email is sent
+		opts = append(opts, asynq.Unique(server.config.PasswordResetInterval))
+	}
+
+	err = server.taskDistributor.DistributeTaskSendResetPasswordEmail(ctx, taskPayload, opts...)
+	if err != nil {
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			return &pb.RequestPasswordResetResponse{}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to send reset password email: %s", err)
+	}
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func validateRequestPasswordResetRequest(req *pb.RequestPasswordResetRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateEmail(req.GetEmail()); err != nil {
+		violations = append(violations, fieldViolation("email", err))
+	}
+
+	return violations
+}
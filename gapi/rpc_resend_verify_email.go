@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ResendVerifyEmail(ctx context.Context, req *pb.ResendVerifyEmailRequest) (*pb.ResendVerifyEmailResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionUsersUpdateOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := server.store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	if user.IsEmailVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "email address is already verified")
+	}
+
+	// an email that was sent recently is still valid
+	verifyEmail, err := server.store.GetLatestVerifyEmail(ctx, user.Username)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get verify email: %s", err)
+	}
+	if err == nil && time.Since(verifyEmail.CreatedAt) < server.config.VerifyEmailInterval {
+		return nil, status.Errorf(codes.ResourceExhausted, "verify email was sent less than %s ago", server.config.VerifyEmailInterval)
+	}
+
+	taskPayload := &worker.PayloadSendVerifyEmail{
+		Username: user.Username,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+	if server.config.VerifyEmailInterval >= time.Second {
+		// covers the requests made before the queued email is sent
+		opts = append(opts, asynq.Unique(server.config.VerifyEmailInterval))
+	}
+
+	err = server.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
+	if err != nil {
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			return nil, status.Errorf(codes.ResourceExhausted, "verify email is already being sent")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to send verify email: %s", err)
+	}
+
+	return &pb.ResendVerifyEmailResponse{}, nil
+}
@@ -0,0 +1,32 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionSessionsManageOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	txResult, err := server.store.RevokeAllSessionsTx(ctx, db.RevokeAllSessionsTxParams{
+		Username: authPayload.Username,
+		Audit:    server.auditMetadata(ctx, authPayload.Username),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to block sessions: %s", err)
+	}
+	server.sessionChecker.ForgetUser(authPayload.Username)
+
+	rsp := &pb.RevokeAllSessionsResponse{
+		RevokedSessions: txResult.RevokedSessions,
+	}
+	return rsp, nil
+}
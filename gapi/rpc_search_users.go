@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// likePatternEscaper escapes the wildcards of a LIKE pattern so the query matches literally
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (server *Server) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	_, err := server.authorizeUser(ctx, util.PermissionUsersReadAny)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateSearchUsersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// already checked by the validation above
+	afterUsername, _ := util.DecodeKeyPageToken(req.GetPageToken())
+
+	// fetch one more row than asked for to know whether there is a next page
+	arg := db.SearchUsersParams{
+		Pattern:       "%" + likePatternEscaper.Replace(req.GetQuery()) + "%",
+		AfterUsername: afterUsername,
+		Limit:         req.GetPageSize() + 1,
+	}
+
+	users, err := server.store.SearchUsers(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search users: %s", err)
+	}
+
+	var nextPageToken string
+	if len(users) > int(req.GetPageSize()) {
+		users = users[:req.GetPageSize()]
+		nextPageToken = util.EncodeKeyPageToken(users[len(users)-1].Username)
+	}
+
+	rsp := &pb.SearchUsersResponse{
+		Users:         make([]*pb.User, len(users)),
+		NextPageToken: nextPageToken,
+	}
+	for i, user := range users {
+		rsp.Users[i] = convertUser(user)
+	}
+	return rsp, nil
+}
+
+func validateSearchUsersRequest(req *pb.SearchUsersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateSearchQuery(req.GetQuery()); err != nil {
+		violations = append(violations, fieldViolation("query", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidateKeyPageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
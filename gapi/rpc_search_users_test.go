@@ -0,0 +1,174 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchUsersAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	depositor, _ := randomUser(t, util.DepositorRole)
+
+	n := 3
+	users := make([]db.User, n)
+	for i := 0; i < n; i++ {
+		users[i], _ = randomUser(t, util.DepositorRole)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.SearchUsersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.SearchUsersResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.SearchUsersRequest{
+				Query:    "john",
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchUsersParams{
+					Pattern: "%john%",
+					Limit:   int32(n) + 1,
+				}
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(users, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetUsers(), n)
+				require.Empty(t, res.GetNextPageToken())
+				for i, user := range res.GetUsers() {
+					require.Equal(t, users[i].Username, user.GetUsername())
+					require.Equal(t, users[i].Email, user.GetEmail())
+				}
+			},
+		},
+		{
+			name: "NextPage",
+			req: &pb.SearchUsersRequest{
+				Query:     "50%_off",
+				PageSize:  int32(n - 1),
+				PageToken: util.EncodeKeyPageToken("abc"),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				// the wildcards in the query are matched literally
+				arg := db.SearchUsersParams{
+					Pattern:       `%50\%\_off%`,
+					AfterUsername: "abc",
+					Limit:         int32(n),
+				}
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(users, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetUsers(), n-1)
+				require.Equal(t, util.EncodeKeyPageToken(users[n-2].Username), res.GetNextPageToken())
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.SearchUsersRequest{
+				Query:    "john",
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.User{}, db.ErrUniqueViolation)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "EmptyQuery",
+			req: &pb.SearchUsersRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req: &pb.SearchUsersRequest{
+				Query:    "john",
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SearchUsers(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetExchangeRate(ctx context.Context, req *pb.SetExchangeRateRequest) (*pb.SetExchangeRateResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, util.PermissionExchangeRatesManageAny)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, violations := validateSetExchangeRateRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	txResult, err := server.store.SetExchangeRateTx(ctx, db.SetExchangeRateTxParams{
+		FromCurrency: req.GetFromCurrency(),
+		ToCurrency:   req.GetToCurrency(),
+		Rate:         rate,
+		Audit:        server.auditMetadata(ctx, authPayload.Username),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set exchange rate: %s", err)
+	}
+
+	rsp := &pb.SetExchangeRateResponse{
+		ExchangeRate: convertExchangeRate(txResult.ExchangeRate),
+	}
+	return rsp, nil
+}
+
+func validateSetExchangeRateRequest(req *pb.SetExchangeRateRequest) (rate pgtype.Numeric, violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetFromCurrency()); err != nil {
+		violations = append(violations, fieldViolation("from_currency", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetToCurrency()); err != nil {
+		violations = append(violations, fieldViolation("to_currency", err))
+	} else if req.GetToCurrency() == req.GetFromCurrency() {
+		violations = append(violations, fieldViolation("to_currency", fmt.Errorf("must be different from from_currency")))
+	}
+
+	rate, err := db.ParseExchangeRate(req.GetRate())
+	if err != nil {
+		violations = append(violations, fieldViolation("rate", err))
+	}
+
+	return rate, violations
+}
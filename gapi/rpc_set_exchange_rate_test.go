@@ -0,0 +1,268 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetExchangeRateAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	user, _ := randomUser(t, util.DepositorRole)
+
+	rate := db.ExchangeRate{
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		Rate:         exchangeRate("0.9215"),
+		UpdatedAt:    time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.SetExchangeRateRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.SetExchangeRateResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Rate:         "0.92150",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SetExchangeRateTxParams{
+					FromCurrency: util.USD,
+					ToCurrency:   util.EUR,
+					Rate:         exchangeRate("0.9215"),
+					Audit:        db.AuditMetadata{Actor: banker.Username},
+				}
+				store.EXPECT().
+					SetExchangeRateTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.SetExchangeRateTxResult{ExchangeRate: rate}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.USD, res.GetExchangeRate().GetFromCurrency())
+				require.Equal(t, util.EUR, res.GetExchangeRate().GetToCurrency())
+				require.Equal(t, "0.9215", res.GetExchangeRate().GetRate())
+			},
+		},
+		{
+			name: "InvalidRate",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Rate:         "-0.92",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetExchangeRateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "RateRoundsToZero",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Rate:         "0.00000000001",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetExchangeRateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "FractionRate",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Rate:         "1/3",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetExchangeRateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "RateTooLarge",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Rate:         "10000000000",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetExchangeRateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "SameCurrency",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.USD,
+				Rate:         "1",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetExchangeRateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "UnsupportedCurrency",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: "XYZ",
+				ToCurrency:   util.EUR,
+				Rate:         "0.92",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetExchangeRateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Rate:         "0.92",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetExchangeRateTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SetExchangeRateTxResult{}, errors.New("connection lost"))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Rate:         "0.92",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetExchangeRateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.SetExchangeRateRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Rate:         "0.92",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetExchangeRateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.SetExchangeRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SetExchangeRate(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -10,15 +10,16 @@ import (
 	"github.com/spaghetti-lover/simplebank/pb"
 	"github.com/spaghetti-lover/simplebank/util"
 	"github.com/spaghetti-lover/simplebank/val"
+	"github.com/spaghetti-lover/simplebank/worker"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
-	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	authPayload, err := server.authorizeUser(ctx, util.PermissionUsersUpdateOwn)
 	if err != nil {
-		return nil, unauthenticatedError(err)
+		return nil, err
 	}
 
 	violations := validateUpdateUserRequest(req)
@@ -26,8 +27,11 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, invalidArgumentError(violations)
 	}
 
-	if authPayload.Role != util.BankerRole && authPayload.Username != req.GetUsername() {
-		return nil, status.Errorf(codes.PermissionDenied, "cannot update other user's info")
+	if authPayload.Username != req.GetUsername() {
+		err = server.checkPermission(ctx, authPayload, util.PermissionUsersUpdateAny)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	arg := db.UpdateUserParams{
@@ -36,14 +40,15 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 			String: req.GetFullName(),
 			Valid:  req.FullName != nil,
 		},
-		Email: pgtype.Text{
+		// a new email only replaces the current one once it is verified
+		PendingEmail: pgtype.Text{
 			String: req.GetEmail(),
 			Valid:  req.Email != nil,
 		},
 	}
 
 	if req.Password != nil {
-		hashedPassword, err := util.HashPassword(req.GetPassword())
+		hashedPassword, err := server.passwordHasher.Hash(req.GetPassword())
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
 		}
@@ -59,7 +64,16 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		}
 	}
 
-	user, err := server.store.UpdateUser(ctx, arg)
+	txResult, err := server.store.UpdateUserTx(ctx, db.UpdateUserTxParams{
+		UpdateUserParams: arg,
+		AfterUpdate: func(user db.User) ([]db.CreateOutboxTaskParams, error) {
+			if req.Email == nil || !user.PendingEmail.Valid {
+				return nil, nil
+			}
+			return newEmailChangeTasks(user)
+		},
+		Audit: server.auditMetadata(ctx, authPayload.Username),
+	})
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "user not found")
@@ -67,12 +81,47 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, status.Errorf(codes.Internal, "failed to update user: %s", err)
 	}
 
+	user := txResult.User
+	if req.Password != nil {
+		// tokens issued before the change must stop working right away
+		server.sessionChecker.ForgetUser(user.Username)
+	}
+
 	rsp := &pb.UpdateUserResponse{
 		User: convertUser(user),
 	}
 	return rsp, nil
 }
 
+// newEmailChangeTasks sends a verification code to the pending email of the user,
+// and lets the current address know about the change
+func newEmailChangeTasks(user db.User) ([]db.CreateOutboxTaskParams, error) {
+	opts := worker.OutboxTaskOptions{
+		Queue:     worker.QueueCritical,
+		MaxRetry:  10,
+		ProcessIn: 10 * time.Second,
+	}
+
+	verifyTask, err := worker.NewOutboxTask(worker.TaskSendVerifyEmailChange, &worker.PayloadSendVerifyEmailChange{
+		Username: user.Username,
+		Email:    user.PendingEmail.String,
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	noticeTask, err := worker.NewOutboxTask(worker.TaskSendEmailChangeNotice, &worker.PayloadSendEmailChangeNotice{
+		Username: user.Username,
+		OldEmail: user.Email,
+		NewEmail: user.PendingEmail.String,
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return []db.CreateOutboxTaskParams{verifyTask, noticeTask}, nil
+}
+
 func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
 	if err := val.ValidateUsername(req.GetUsername()); err != nil {
 		violations = append(violations, fieldViolation("username", err))
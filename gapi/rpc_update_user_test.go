@@ -2,6 +2,8 @@ package gapi
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -12,11 +14,41 @@ import (
 	"github.com/spaghetti-lover/simplebank/pb"
 	"github.com/spaghetti-lover/simplebank/token"
 	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/worker"
+	mockwk "github.com/spaghetti-lover/simplebank/worker/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+type eqUpdateUserTxParamsMatcher struct {
+	arg   db.UpdateUserParams
+	user  db.User
+	tasks []db.CreateOutboxTaskParams
+}
+
+func (expected eqUpdateUserTxParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.UpdateUserTxParams)
+	if !ok {
+		return false
+	}
+
+	if !reflect.DeepEqual(expected.arg, actualArg.UpdateUserParams) {
+		return false
+	}
+
+	tasks, err := actualArg.AfterUpdate(expected.user)
+	return err == nil && eqOutboxTasks(expected.tasks, tasks)
+}
+
+func (e eqUpdateUserTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches arg %v", e.arg)
+}
+
+func EqUpdateUserTxParams(arg db.UpdateUserParams, user db.User, tasks []db.CreateOutboxTaskParams) gomock.Matcher {
+	return eqUpdateUserTxParamsMatcher{arg, user, tasks}
+}
+
 func TestUpdateUserAPI(t *testing.T) {
 	user, _ := randomUser(t, util.DepositorRole)
 	other, _ := randomUser(t, util.DepositorRole)
@@ -29,7 +61,7 @@ func TestUpdateUserAPI(t *testing.T) {
 	testCases := []struct {
 		name          string
 		req           *pb.UpdateUserRequest
-		buildStubs    func(store *mockdb.MockStore)
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
 		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
 		checkResponse func(t *testing.T, res *pb.UpdateUserResponse, err error)
 	}{
@@ -40,14 +72,14 @@ func TestUpdateUserAPI(t *testing.T) {
 				FullName: &newName,
 				Email:    &newEmail,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				arg := db.UpdateUserParams{
 					Username: user.Username,
 					FullName: pgtype.Text{
 						String: newName,
 						Valid:  true,
 					},
-					Email: pgtype.Text{
+					PendingEmail: pgtype.Text{
 						String: newEmail,
 						Valid:  true,
 					},
@@ -56,15 +88,27 @@ func TestUpdateUserAPI(t *testing.T) {
 					Username:          user.Username,
 					HashedPassword:    user.HashedPassword,
 					FullName:          newName,
-					Email:             newEmail,
+					Email:             user.Email,
+					PendingEmail:      pgtype.Text{String: newEmail, Valid: true},
 					PasswordChangedAt: user.PasswordChangedAt,
 					CreatedAt:         user.CreatedAt,
 					IsEmailVerified:   user.IsEmailVerified,
 				}
+				tasks := []db.CreateOutboxTaskParams{
+					newTestOutboxTask(t, worker.TaskSendVerifyEmailChange, &worker.PayloadSendVerifyEmailChange{
+						Username: user.Username,
+						Email:    newEmail,
+					}),
+					newTestOutboxTask(t, worker.TaskSendEmailChangeNotice, &worker.PayloadSendEmailChangeNotice{
+						Username: user.Username,
+						OldEmail: user.Email,
+						NewEmail: newEmail,
+					}),
+				}
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Eq(arg)).
+					UpdateUserTx(gomock.Any(), EqUpdateUserTxParams(arg, updatedUser, tasks)).
 					Times(1).
-					Return(updatedUser, nil)
+					Return(db.UpdateUserTxResult{User: updatedUser}, nil)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
 				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
@@ -75,7 +119,8 @@ func TestUpdateUserAPI(t *testing.T) {
 				updatedUser := res.GetUser()
 				require.Equal(t, user.Username, updatedUser.Username)
 				require.Equal(t, newName, updatedUser.FullName)
-				require.Equal(t, newEmail, updatedUser.Email)
+				require.Equal(t, user.Email, updatedUser.Email)
+				require.Equal(t, newEmail, updatedUser.GetPendingEmail())
 			},
 		},
 		{
@@ -85,14 +130,14 @@ func TestUpdateUserAPI(t *testing.T) {
 				FullName: &newName,
 				Email:    &newEmail,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				arg := db.UpdateUserParams{
 					Username: user.Username,
 					FullName: pgtype.Text{
 						String: newName,
 						Valid:  true,
 					},
-					Email: pgtype.Text{
+					PendingEmail: pgtype.Text{
 						String: newEmail,
 						Valid:  true,
 					},
@@ -101,15 +146,27 @@ func TestUpdateUserAPI(t *testing.T) {
 					Username:          user.Username,
 					HashedPassword:    user.HashedPassword,
 					FullName:          newName,
-					Email:             newEmail,
+					Email:             user.Email,
+					PendingEmail:      pgtype.Text{String: newEmail, Valid: true},
 					PasswordChangedAt: user.PasswordChangedAt,
 					CreatedAt:         user.CreatedAt,
 					IsEmailVerified:   user.IsEmailVerified,
 				}
+				tasks := []db.CreateOutboxTaskParams{
+					newTestOutboxTask(t, worker.TaskSendVerifyEmailChange, &worker.PayloadSendVerifyEmailChange{
+						Username: user.Username,
+						Email:    newEmail,
+					}),
+					newTestOutboxTask(t, worker.TaskSendEmailChangeNotice, &worker.PayloadSendEmailChangeNotice{
+						Username: user.Username,
+						OldEmail: user.Email,
+						NewEmail: newEmail,
+					}),
+				}
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Eq(arg)).
+					UpdateUserTx(gomock.Any(), EqUpdateUserTxParams(arg, updatedUser, tasks)).
 					Times(1).
-					Return(updatedUser, nil)
+					Return(db.UpdateUserTxResult{User: updatedUser}, nil)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
 				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
@@ -120,7 +177,39 @@ func TestUpdateUserAPI(t *testing.T) {
 				updatedUser := res.GetUser()
 				require.Equal(t, user.Username, updatedUser.Username)
 				require.Equal(t, newName, updatedUser.FullName)
-				require.Equal(t, newEmail, updatedUser.Email)
+				require.Equal(t, user.Email, updatedUser.Email)
+				require.Equal(t, newEmail, updatedUser.GetPendingEmail())
+			},
+		},
+		{
+			name: "OnlyFullName",
+			req: &pb.UpdateUserRequest{
+				Username: user.Username,
+				FullName: &newName,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				arg := db.UpdateUserParams{
+					Username: user.Username,
+					FullName: pgtype.Text{
+						String: newName,
+						Valid:  true,
+					},
+				}
+				updatedUser := user
+				updatedUser.FullName = newName
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), EqUpdateUserTxParams(arg, updatedUser, nil)).
+					Times(1).
+					Return(db.UpdateUserTxResult{User: updatedUser}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, newName, res.GetUser().FullName)
+				require.Equal(t, user.Email, res.GetUser().Email)
+				require.Nil(t, res.GetUser().PendingEmail)
 			},
 		},
 		{
@@ -130,9 +219,9 @@ func TestUpdateUserAPI(t *testing.T) {
 				FullName: &newName,
 				Email:    &newEmail,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
@@ -152,11 +241,11 @@ func TestUpdateUserAPI(t *testing.T) {
 				FullName: &newName,
 				Email:    &newEmail,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(db.User{}, db.ErrRecordNotFound)
+					Return(db.UpdateUserTxResult{}, db.ErrRecordNotFound)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
 				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
@@ -175,9 +264,9 @@ func TestUpdateUserAPI(t *testing.T) {
 				FullName: &newName,
 				Email:    &invalidEmail,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
@@ -197,9 +286,9 @@ func TestUpdateUserAPI(t *testing.T) {
 				FullName: &newName,
 				Email:    &newEmail,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
@@ -219,9 +308,9 @@ func TestUpdateUserAPI(t *testing.T) {
 				FullName: &newName,
 				Email:    &newEmail,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
@@ -241,9 +330,9 @@ func TestUpdateUserAPI(t *testing.T) {
 				FullName: &newName,
 				Email:    &newEmail,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
@@ -266,8 +355,12 @@ func TestUpdateUserAPI(t *testing.T) {
 			defer storeCtrl.Finish()
 			store := mockdb.NewMockStore(storeCtrl)
 
-			tc.buildStubs(store)
-			server := newTestServer(t, store, nil)
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
 
 			ctx := tc.buildContext(t, server.tokenMaker)
 			res, err := server.UpdateUser(ctx, tc.req)
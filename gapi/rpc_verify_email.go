@@ -2,6 +2,7 @@ package gapi
 
 import (
 	"context"
+	"errors"
 
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"github.com/spaghetti-lover/simplebank/pb"
@@ -20,8 +21,18 @@ func (server *Server) VerifyEmail(ctx context.Context, req *pb.VerifyEmailReques
 	txResult, err := server.store.VerifyEmailTx(ctx, db.VerifyEmailTxParams{
 		EmailId:    req.GetEmailId(),
 		SecretCode: req.GetSecretCode(),
+		Audit:      server.auditMetadata(ctx, ""),
 	})
 	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "email is already used by another user")
+		}
+		if errors.Is(err, db.ErrPendingEmailSuperseded) {
+			return nil, status.Errorf(codes.FailedPrecondition, "email change was superseded by a newer one, use the code sent to the new address")
+		}
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "verification code is invalid, used or expired")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to verify email")
 	}
 
@@ -0,0 +1,143 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerifyEmailAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	user.IsEmailVerified = true
+	emailID := util.RandomInt(1, 1000)
+	secretCode := util.RandomString(32)
+
+	testCases := []struct {
+		name          string
+		req           *pb.VerifyEmailRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.VerifyEmailResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.VerifyEmailRequest{EmailId: emailID, SecretCode: secretCode},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.VerifyEmailTxParams) (db.VerifyEmailTxResult, error) {
+						require.Equal(t, emailID, arg.EmailId)
+						require.Equal(t, secretCode, arg.SecretCode)
+						return db.VerifyEmailTxResult{User: user}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetIsVerified())
+			},
+		},
+		{
+			name: "PendingEmailSuperseded",
+			req:  &pb.VerifyEmailRequest{EmailId: emailID, SecretCode: secretCode},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, db.ErrPendingEmailSuperseded)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+				require.Contains(t, st.Message(), "superseded")
+			},
+		},
+		{
+			name: "InvalidCode",
+			req:  &pb.VerifyEmailRequest{EmailId: emailID, SecretCode: secretCode},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "EmailTaken",
+			req:  &pb.VerifyEmailRequest{EmailId: emailID, SecretCode: secretCode},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, db.ErrUniqueViolation)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.AlreadyExists, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.VerifyEmailRequest{EmailId: emailID, SecretCode: secretCode},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, fmt.Errorf("connection lost"))
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "InvalidSecretCode",
+			req:  &pb.VerifyEmailRequest{EmailId: emailID, SecretCode: "short"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().VerifyEmailTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			res, err := server.VerifyEmail(context.Background(), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/simplebank/auth"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) VerifyTwoFactorLogin(ctx context.Context, req *pb.VerifyTwoFactorLoginRequest) (*pb.LoginUserResponse, error) {
+	violations := validateVerifyTwoFactorLoginRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	mfaPayload, err := server.tokenMaker.VerifyToken(req.GetMfaToken(), token.TokenTypeMFAToken)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	// codes are guessed against the same limits as passwords
+	mtdt := server.extractMetadata(ctx)
+	attempt, err := server.beginLoginAttempt(ctx, mfaPayload.Username, mtdt.ClientIP)
+	if err != nil {
+		return nil, err
+	}
+	defer server.releaseLoginAttempt(ctx, attempt)
+
+	err = server.loginGuard.RecordMFAAttempt(ctx, mfaPayload.ID, mfaPayload.ExpiredAt)
+	if err != nil {
+		if errors.Is(err, auth.ErrMFAAttemptsExhausted) {
+			return nil, status.Errorf(codes.Unauthenticated, "too many attempts with this mfa token, log in again")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to record mfa attempt: %s", err)
+	}
+
+	user, err := server.store.GetUser(ctx, mfaPayload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find user")
+	}
+
+	// the password may have changed since the challenge was issued
+	if mfaPayload.IssuedAt.Before(user.PasswordChangedAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "mfa token was issued before the last password change")
+	}
+
+	if !user.IsTotpEnabled {
+		return nil, status.Errorf(codes.Unauthenticated, "two-factor authentication is not enabled")
+	}
+
+	err = server.verifySecondFactor(ctx, user, req.GetCode())
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			if err := server.recordLoginFailure(ctx, attempt, user.Username, &user); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+
+	err = attempt.Succeed(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reset login attempts: %s", err)
+	}
+
+	return server.createLoginSession(ctx, user)
+}
+
+func validateVerifyTwoFactorLoginRequest(req *pb.VerifyTwoFactorLoginRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateToken(req.GetMfaToken()); err != nil {
+		violations = append(violations, fieldViolation("mfa_token", err))
+	}
+
+	if err := val.ValidateTwoFactorCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,313 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/auth"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	mockwk "github.com/spaghetti-lover/simplebank/worker/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerifyTwoFactorLoginAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	user.IsTotpEnabled = true
+
+	recoveryCodes, err := auth.GenerateRecoveryCodes()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		tokenType     token.TokenType
+		buildRequest  func(t *testing.T, mfaToken string, secret string) *pb.VerifyTwoFactorLoginRequest
+		buildStubs    func(store *mockdb.MockStore, user db.User)
+		checkResponse func(t *testing.T, res *pb.LoginUserResponse, err error)
+	}{
+		{
+			name:      "OK",
+			tokenType: token.TokenTypeMFAToken,
+			buildRequest: func(t *testing.T, mfaToken string, secret string) *pb.VerifyTwoFactorLoginRequest {
+				code, err := auth.TOTPCode(secret, time.Now())
+				require.NoError(t, err)
+				return &pb.VerifyTwoFactorLoginRequest{MfaToken: mfaToken, Code: code}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().UseUserTotpStep(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+				store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CreateSessionTxResult{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetMfaRequired())
+				require.NotEmpty(t, res.GetAccessToken())
+				require.NotEmpty(t, res.GetRefreshToken())
+			},
+		},
+		{
+			name:      "RecoveryCode",
+			tokenType: token.TokenTypeMFAToken,
+			buildRequest: func(t *testing.T, mfaToken string, secret string) *pb.VerifyTwoFactorLoginRequest {
+				return &pb.VerifyTwoFactorLoginRequest{MfaToken: mfaToken, Code: recoveryCodes[0]}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				arg := db.UseUserRecoveryCodeParams{
+					Username:     user.Username,
+					RecoveryCode: auth.HashRecoveryCode(recoveryCodes[0]),
+				}
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().UseUserRecoveryCode(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+				store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CreateSessionTxResult{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+			},
+		},
+		{
+			name:      "CodeAlreadyUsed",
+			tokenType: token.TokenTypeMFAToken,
+			buildRequest: func(t *testing.T, mfaToken string, secret string) *pb.VerifyTwoFactorLoginRequest {
+				code, err := auth.TOTPCode(secret, time.Now())
+				require.NoError(t, err)
+				return &pb.VerifyTwoFactorLoginRequest{MfaToken: mfaToken, Code: code}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().UseUserTotpStep(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+				store.EXPECT().
+					RecordLoginFailureTx(gomock.Any(), gomock.Eq(db.RecordLoginFailureTxParams{Username: user.Username})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:      "WrongCode",
+			tokenType: token.TokenTypeMFAToken,
+			buildRequest: func(t *testing.T, mfaToken string, secret string) *pb.VerifyTwoFactorLoginRequest {
+				code, err := auth.TOTPCode(secret, time.Now().Add(-time.Hour))
+				require.NoError(t, err)
+				return &pb.VerifyTwoFactorLoginRequest{MfaToken: mfaToken, Code: code}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().UseUserTotpStep(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					RecordLoginFailureTx(gomock.Any(), gomock.Eq(db.RecordLoginFailureTxParams{Username: user.Username})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:      "TwoFactorDisabled",
+			tokenType: token.TokenTypeMFAToken,
+			buildRequest: func(t *testing.T, mfaToken string, secret string) *pb.VerifyTwoFactorLoginRequest {
+				return &pb.VerifyTwoFactorLoginRequest{MfaToken: mfaToken, Code: "123456"}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				user.IsTotpEnabled = false
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:      "PasswordChanged",
+			tokenType: token.TokenTypeMFAToken,
+			buildRequest: func(t *testing.T, mfaToken string, secret string) *pb.VerifyTwoFactorLoginRequest {
+				return &pb.VerifyTwoFactorLoginRequest{MfaToken: mfaToken, Code: "123456"}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				user.PasswordChangedAt = time.Now().Add(time.Minute)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:      "WrongTokenType",
+			tokenType: token.TokenTypeAccessToken,
+			buildRequest: func(t *testing.T, mfaToken string, secret string) *pb.VerifyTwoFactorLoginRequest {
+				return &pb.VerifyTwoFactorLoginRequest{MfaToken: mfaToken, Code: "123456"}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:      "InvalidCode",
+			tokenType: token.TokenTypeMFAToken,
+			buildRequest: func(t *testing.T, mfaToken string, secret string) *pb.VerifyTwoFactorLoginRequest {
+				return &pb.VerifyTwoFactorLoginRequest{MfaToken: mfaToken, Code: "12"}
+			},
+			buildStubs: func(store *mockdb.MockStore, user db.User) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			server := newTestServer(t, store, nil)
+			enabledUser, secret := withTOTPSecret(t, server, user)
+
+			tc.buildStubs(store, enabledUser)
+
+			mfaToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, uuid.Nil, time.Minute, tc.tokenType)
+			require.NoError(t, err)
+
+			res, err := server.VerifyTwoFactorLogin(context.Background(), tc.buildRequest(t, mfaToken, secret))
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestVerifyTwoFactorLoginUsedUpToken(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	user.IsTotpEnabled = true
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	server := newTestServer(t, store, nil)
+	server.loginGuard = auth.NewLoginGuard(auth.NewMemoryLoginAttemptCounter(), auth.LoginGuardConfig{
+		LockoutDuration: time.Minute,
+		MaxMFAAttempts:  2,
+	})
+	enabledUser, secret := withTOTPSecret(t, server, user)
+
+	mfaToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, uuid.Nil, time.Minute, token.TokenTypeMFAToken)
+	require.NoError(t, err)
+
+	wrongCode, err := auth.TOTPCode(secret, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(2).Return(enabledUser, nil)
+	store.EXPECT().RecordLoginFailureTx(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+	for i := 0; i < 2; i++ {
+		_, err = server.VerifyTwoFactorLogin(context.Background(), &pb.VerifyTwoFactorLoginRequest{
+			MfaToken: mfaToken,
+			Code:     wrongCode,
+		})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	// the token is used up, even the right code is refused
+	code, err := auth.TOTPCode(secret, time.Now())
+	require.NoError(t, err)
+	store.EXPECT().UseUserTotpStep(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().CreateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+	_, err = server.VerifyTwoFactorLogin(context.Background(), &pb.VerifyTwoFactorLoginRequest{
+		MfaToken: mfaToken,
+		Code:     code,
+	})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestVerifyTwoFactorLoginLockout(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	user.IsTotpEnabled = true
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	taskCtrl := gomock.NewController(t)
+	defer taskCtrl.Finish()
+	taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+	server := newTestServer(t, store, taskDistributor)
+	server.loginGuard = auth.NewLoginGuard(auth.NewMemoryLoginAttemptCounter(), auth.LoginGuardConfig{
+		MaxFailures:     2,
+		LockoutDuration: time.Minute,
+	})
+	enabledUser, secret := withTOTPSecret(t, server, user)
+
+	wrongCode, err := auth.TOTPCode(secret, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	// wrong codes count as failed logins, even with a new token each time
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(2).Return(enabledUser, nil)
+	taskDistributor.EXPECT().DistributeTaskSendLoginLockoutEmail(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	store.EXPECT().
+		RecordLoginFailureTx(gomock.Any(), gomock.Eq(db.RecordLoginFailureTxParams{Username: user.Username, Locked: true})).
+		Times(1).
+		Return(nil)
+	store.EXPECT().
+		RecordLoginFailureTx(gomock.Any(), gomock.Eq(db.RecordLoginFailureTxParams{Username: user.Username})).
+		Times(1).
+		Return(nil)
+	for i := 0; i < 2; i++ {
+		mfaToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, uuid.Nil, time.Minute, token.TokenTypeMFAToken)
+		require.NoError(t, err)
+
+		_, err = server.VerifyTwoFactorLogin(context.Background(), &pb.VerifyTwoFactorLoginRequest{
+			MfaToken: mfaToken,
+			Code:     wrongCode,
+		})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	mfaToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, uuid.Nil, time.Minute, token.TokenTypeMFAToken)
+	require.NoError(t, err)
+	code, err := auth.TOTPCode(secret, time.Now())
+	require.NoError(t, err)
+
+	_, err = server.VerifyTwoFactorLogin(context.Background(), &pb.VerifyTwoFactorLoginRequest{
+		MfaToken: mfaToken,
+		Code:     code,
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -0,0 +1,107 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultWatchSessionCheckInterval is used when sessions are not cached, and so have no TTL to follow
+const defaultWatchSessionCheckInterval = 30 * time.Second
+
+func (server *Server) WatchAccount(req *pb.WatchAccountRequest, stream pb.SimpleBank_WatchAccountServer) error {
+	authPayload, err := server.authorizeUser(stream.Context(), util.PermissionAccountsReadOwn)
+	if err != nil {
+		return err
+	}
+
+	// the stream must not outlive the access token it was opened with
+	ctx, cancel := context.WithDeadline(stream.Context(), authPayload.ExpiredAt)
+	defer cancel()
+
+	violations := validateWatchAccountRequest(req)
+	if violations != nil {
+		return invalidArgumentError(violations)
+	}
+
+	if server.accountWatcher == nil {
+		return status.Errorf(codes.Unavailable, "account activity is not available")
+	}
+
+	// watch before reading the account, so no activity is missed in between
+	activities := server.accountWatcher.WatchAccount(ctx, req.GetAccountId())
+
+	account, err := server.store.GetAccount(ctx, req.GetAccountId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "account not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Owner != authPayload.Username {
+		err = server.checkPermission(ctx, authPayload, util.PermissionAccountsReadAny)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = stream.Send(&pb.WatchAccountResponse{
+		Account: convertAccount(account),
+	})
+	if err != nil {
+		return err
+	}
+
+	// a logout, a revoked session or a changed password ends the stream as well,
+	// checked as often as the session checker may cache the session
+	sessionCheckInterval := server.config.SessionCacheTTL
+	if sessionCheckInterval <= 0 {
+		sessionCheckInterval = defaultWatchSessionCheckInterval
+	}
+	sessionCheck := time.NewTicker(sessionCheckInterval)
+	defer sessionCheck.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if stream.Context().Err() != nil {
+				return nil
+			}
+			return status.Errorf(codes.Unauthenticated, "access token expired")
+		case <-sessionCheck.C:
+			err = server.sessionChecker.CheckSession(ctx, authPayload)
+			if err != nil {
+				return status.Errorf(codes.Unauthenticated, "revoked access token: %s", err)
+			}
+		case activity, ok := <-activities:
+			if !ok {
+				return status.Errorf(codes.Unavailable, "missed some account activity, watch the account again")
+			}
+
+			err = stream.Send(&pb.WatchAccountResponse{
+				Account: convertAccount(activity.Account),
+				Entry:   convertEntry(activity.Entry),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func validateWatchAccountRequest(req *pb.WatchAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,298 @@
+package gapi
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/auth"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testWatchAccountStream collects the responses of WatchAccount
+type testWatchAccountStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *pb.WatchAccountResponse
+}
+
+func newTestWatchAccountStream(ctx context.Context) *testWatchAccountStream {
+	return &testWatchAccountStream{
+		ctx:       ctx,
+		responses: make(chan *pb.WatchAccountResponse, 2*accountWatchBuffer),
+	}
+}
+
+func (stream *testWatchAccountStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *testWatchAccountStream) Send(rsp *pb.WatchAccountResponse) error {
+	stream.responses <- rsp
+	return nil
+}
+
+func (stream *testWatchAccountStream) next(t *testing.T) *pb.WatchAccountResponse {
+	select {
+	case rsp := <-stream.responses:
+		return rsp
+	case <-time.After(time.Second):
+		require.FailNow(t, "no response from WatchAccount")
+		return nil
+	}
+}
+
+// revocableSessions is a SessionChecker that honours every token until it is revoked
+type revocableSessions struct {
+	revoked atomic.Bool
+}
+
+func (sessions *revocableSessions) CheckSession(ctx context.Context, payload *token.Payload) error {
+	if sessions.revoked.Load() {
+		return auth.ErrSessionBlocked
+	}
+	return nil
+}
+
+func (sessions *revocableSessions) ForgetSession(sessionID uuid.UUID) {}
+func (sessions *revocableSessions) ForgetUser(username string)        {}
+
+func randomAccountActivity(account db.Account) db.AccountActivity {
+	amount := util.RandomMoney()
+	account.Balance += amount
+
+	return db.AccountActivity{
+		Account: account,
+		Entry: db.Entry{
+			ID:        util.RandomInt(1, 1000),
+			AccountID: account.ID,
+			Amount:    amount,
+			Balance:   account.Balance,
+			CreatedAt: time.Now().Truncate(time.Microsecond),
+		},
+	}
+}
+
+func TestWatchAccountAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+	account := randomAccount(user.Username)
+	otherAccount := randomAccount(banker.Username)
+	sessions := &revocableSessions{}
+
+	testCases := []struct {
+		name         string
+		accountID    int64
+		noWatcher    bool
+		sessions     auth.SessionChecker
+		buildStubs   func(store *mockdb.MockStore, hub *AccountActivityHub)
+		buildContext func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkStream  func(t *testing.T, hub *AccountActivityHub, stream *testWatchAccountStream, cancel context.CancelFunc)
+		code         codes.Code
+	}{
+		{
+			name:      "OK",
+			accountID: account.ID,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkStream: func(t *testing.T, hub *AccountActivityHub, stream *testWatchAccountStream, cancel context.CancelFunc) {
+				rsp := stream.next(t)
+				require.Equal(t, account.Balance, rsp.GetAccount().GetBalance())
+				require.Nil(t, rsp.GetEntry())
+
+				activity := randomAccountActivity(account)
+				hub.Publish(randomAccountActivity(otherAccount))
+				hub.Publish(activity)
+
+				rsp = stream.next(t)
+				require.Equal(t, activity.Account.Balance, rsp.GetAccount().GetBalance())
+				require.Equal(t, activity.Entry.ID, rsp.GetEntry().GetId())
+				require.Equal(t, activity.Entry.Amount, rsp.GetEntry().GetAmount())
+
+				cancel()
+			},
+			code: codes.OK,
+		},
+		{
+			name:      "BankerWatchesOtherOwner",
+			accountID: account.ID,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkStream: func(t *testing.T, hub *AccountActivityHub, stream *testWatchAccountStream, cancel context.CancelFunc) {
+				rsp := stream.next(t)
+				require.Equal(t, account.ID, rsp.GetAccount().GetId())
+				cancel()
+			},
+			code: codes.OK,
+		},
+		{
+			name:      "TokenExpires",
+			accountID: account.ID,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, 1500*time.Millisecond, token.TokenTypeAccessToken)
+			},
+			checkStream: func(t *testing.T, hub *AccountActivityHub, stream *testWatchAccountStream, cancel context.CancelFunc) {
+				rsp := stream.next(t)
+				require.Equal(t, account.ID, rsp.GetAccount().GetId())
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:      "SessionRevoked",
+			accountID: account.ID,
+			sessions:  sessions,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			checkStream: func(t *testing.T, hub *AccountActivityHub, stream *testWatchAccountStream, cancel context.CancelFunc) {
+				rsp := stream.next(t)
+				require.Equal(t, account.ID, rsp.GetAccount().GetId())
+				sessions.revoked.Store(true)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:      "FallsBehind",
+			accountID: account.ID,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				// the activity arrives while the account is read, before anything drains the watch
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ int64) (db.Account, error) {
+						for i := 0; i <= accountWatchBuffer; i++ {
+							hub.Publish(randomAccountActivity(account))
+						}
+						return account, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			code: codes.Unavailable,
+		},
+		{
+			name:      "PermissionDenied",
+			accountID: otherAccount.ID,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(otherAccount.ID)).Times(1).Return(otherAccount, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:      "NotFound",
+			accountID: account.ID,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name:      "NoWatcher",
+			accountID: account.ID,
+			noWatcher: true,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			code: codes.Unavailable,
+		},
+		{
+			name:      "InvalidAccountID",
+			accountID: 0,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute, token.TokenTypeAccessToken)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:      "NoAuthorization",
+			accountID: account.ID,
+			buildStubs: func(store *mockdb.MockStore, hub *AccountActivityHub) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			code: codes.Unauthenticated,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			hub := NewAccountActivityHub()
+			tc.buildStubs(store, hub)
+
+			server := newTestServer(t, store, nil)
+			if !tc.noWatcher {
+				server.accountWatcher = hub
+			}
+			if tc.sessions != nil {
+				server.sessionChecker = tc.sessions
+				server.config.SessionCacheTTL = 10 * time.Millisecond
+			}
+
+			ctx, cancel := context.WithCancel(tc.buildContext(t, server.tokenMaker))
+			defer cancel()
+			stream := newTestWatchAccountStream(ctx)
+
+			errc := make(chan error, 1)
+			go func() {
+				errc <- server.WatchAccount(&pb.WatchAccountRequest{AccountId: tc.accountID}, stream)
+			}()
+
+			if tc.checkStream != nil {
+				tc.checkStream(t, hub, stream, cancel)
+			}
+
+			select {
+			case err := <-errc:
+				require.Equal(t, tc.code, status.Code(err))
+			case <-time.After(3 * time.Second):
+				require.FailNow(t, "WatchAccount did not return")
+			}
+		})
+	}
+}
@@ -2,8 +2,13 @@ package gapi
 
 import (
 	"fmt"
+	"net"
+	"net/netip"
+	"sync"
 
+	"github.com/spaghetti-lover/simplebank/auth"
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/exchange"
 	"github.com/spaghetti-lover/simplebank/pb"
 	"github.com/spaghetti-lover/simplebank/token"
 	"github.com/spaghetti-lover/simplebank/util"
@@ -16,21 +21,91 @@ type Server struct {
 	config          util.Config
 	store           db.Store
 	tokenMaker      token.Maker
+	passwordHasher  util.PasswordHasher
+	dummyHash       func() (string, error)
 	taskDistributor worker.TaskDistributor
+	rateProvider    exchange.RateProvider
+	sessionChecker  auth.SessionChecker
+	permissions     auth.PermissionChecker
+	policy          Policy
+	loginGuard      *auth.LoginGuard
+	secretCipher    *auth.SecretCipher
+	webhookCipher   *auth.SecretCipher
+	accountWatcher  AccountWatcher
+	trustedProxies  []netip.Prefix
+	resolver        hostResolver
 }
 
 // NewServer creates a new gRPC server.
-func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+// WatchAccount is unavailable when accountWatcher is nil.
+func NewServer(
+	config util.Config,
+	store db.Store,
+	taskDistributor worker.TaskDistributor,
+	accountWatcher AccountWatcher,
+) (*Server, error) {
+	tokenMaker, err := token.NewMakerFromConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	passwordHasher, err := util.NewPasswordHasherFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create password hasher: %w", err)
+	}
+
+	trustedProxies, err := parseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse trusted proxies: %w", err)
+	}
+
 	server := &Server{
 		config:          config,
 		store:           store,
 		tokenMaker:      tokenMaker,
+		passwordHasher:  passwordHasher,
 		taskDistributor: taskDistributor,
+		rateProvider:    exchange.NewDBRateProvider(store),
+		sessionChecker:  auth.NewCachedSessionChecker(store, config.SessionCacheTTL),
+		permissions:     auth.NewCachedPermissionChecker(store, config.PermissionCacheTTL),
+		policy:          NewVerifiedEmailPolicy(store),
+		accountWatcher:  accountWatcher,
+		trustedProxies:  trustedProxies,
+		resolver:        net.DefaultResolver,
+	}
+
+	// checked instead of a password when the user does not exist, so that it takes as long
+	server.dummyHash = sync.OnceValues(func() (string, error) {
+		return server.passwordHasher.Hash(util.RandomString(32))
+	})
+
+	loginAttempts, err := auth.NewLoginAttemptCounterFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create login attempt counter: %w", err)
+	}
+	server.loginGuard = auth.NewLoginGuard(loginAttempts, auth.LoginGuardConfig{
+		MaxFailures:     config.LoginMaxFailures,
+		MaxIPFailures:   config.LoginMaxIPFailures,
+		LockoutDuration: config.LoginLockoutDuration,
+		FailureDelay:    config.LoginFailureDelay,
+		MaxFailureDelay: config.LoginMaxFailureDelay,
+		MaxMFAAttempts:  config.LoginMaxMFAAttempts,
+	})
+
+	// two-factor authentication is unavailable without an encryption key
+	if config.TOTPEncryptionKey != "" {
+		server.secretCipher, err = auth.NewSecretCipher(config.TOTPEncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create TOTP secret cipher: %w", err)
+		}
+	}
+
+	// webhooks are unavailable without an encryption key
+	if config.WebhookEncryptionKey != "" {
+		server.webhookCipher, err = auth.NewSecretCipher(config.WebhookEncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create webhook secret cipher: %w", err)
+		}
 	}
 
 	return server, nil
@@ -0,0 +1,92 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// getReadableAccount returns the account if it belongs to the authenticated user,
+// or if the user can read any account
+func (server *Server) getReadableAccount(ctx context.Context, authPayload *token.Payload, accountID int64) (db.Account, error) {
+	account, err := server.getAccount(ctx, accountID)
+	if err != nil {
+		return account, err
+	}
+
+	if account.Owner != authPayload.Username {
+		err = server.checkPermission(ctx, authPayload, util.PermissionAccountsReadAny)
+		if err != nil {
+			return account, err
+		}
+	}
+
+	return account, nil
+}
+
+// statementPeriod returns the [start, end) range to list, defaulting to everything up to now
+func statementPeriod(startTime *timestamppb.Timestamp, endTime *timestamppb.Timestamp) (start time.Time, end time.Time) {
+	if startTime != nil {
+		start = startTime.AsTime()
+	}
+
+	end = time.Now()
+	if endTime != nil {
+		end = endTime.AsTime()
+	}
+
+	return start, end
+}
+
+// directionFilter tells which side of the account to include, both when the direction is unspecified
+func directionFilter(direction pb.Direction) (incoming bool, outgoing bool) {
+	switch direction {
+	case pb.Direction_DIRECTION_INCOMING:
+		return true, false
+	case pb.Direction_DIRECTION_OUTGOING:
+		return false, true
+	}
+	return true, true
+}
+
+func validateStatementFilters(
+	startTime *timestamppb.Timestamp,
+	endTime *timestamppb.Timestamp,
+	direction pb.Direction,
+) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateStatementPeriod(startTime, endTime)
+
+	if _, ok := pb.Direction_name[int32(direction)]; !ok {
+		violations = append(violations, fieldViolation("direction", fmt.Errorf("unknown direction")))
+	}
+
+	return violations
+}
+
+func validateStatementPeriod(
+	startTime *timestamppb.Timestamp,
+	endTime *timestamppb.Timestamp,
+) (violations []*errdetails.BadRequest_FieldViolation) {
+	if startTime != nil {
+		if err := startTime.CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("start_time", err))
+		}
+	}
+
+	if endTime != nil {
+		if err := endTime.CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("end_time", err))
+		} else if startTime != nil && !endTime.AsTime().After(startTime.AsTime()) {
+			violations = append(violations, fieldViolation("end_time", fmt.Errorf("must be after start_time")))
+		}
+	}
+
+	return violations
+}
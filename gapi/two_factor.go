@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/simplebank/auth"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/val"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// decryptTOTPSecret returns the plain TOTP secret stored for the user
+func (server *Server) decryptTOTPSecret(user db.User) (string, error) {
+	if server.secretCipher == nil {
+		return "", status.Errorf(codes.FailedPrecondition, "two-factor authentication is not configured")
+	}
+
+	if !user.TotpSecret.Valid {
+		return "", status.Errorf(codes.FailedPrecondition, "two-factor authentication is not set up")
+	}
+
+	secret, err := server.secretCipher.Decrypt(user.TotpSecret.String)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to decrypt TOTP secret")
+	}
+
+	return secret, nil
+}
+
+// verifySecondFactor accepts a TOTP code that was not used before, or an unused recovery code.
+// Either one is consumed on success.
+func (server *Server) verifySecondFactor(ctx context.Context, user db.User, code string) error {
+	if val.ValidateTOTPCode(code) == nil {
+		secret, err := server.decryptTOTPSecret(user)
+		if err != nil {
+			return err
+		}
+
+		step, ok := auth.ValidateTOTP(secret, code, time.Now())
+		if !ok {
+			return status.Errorf(codes.Unauthenticated, "invalid two-factor code")
+		}
+
+		rows, err := server.store.UseUserTotpStep(ctx, db.UseUserTotpStepParams{
+			Username:     user.Username,
+			TotpLastStep: step,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to use TOTP code: %s", err)
+		}
+		if rows == 0 {
+			return status.Errorf(codes.Unauthenticated, "two-factor code was already used")
+		}
+
+		return nil
+	}
+
+	rows, err := server.store.UseUserRecoveryCode(ctx, db.UseUserRecoveryCodeParams{
+		Username:     user.Username,
+		RecoveryCode: auth.HashRecoveryCode(code),
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to use recovery code: %s", err)
+	}
+	if rows == 0 {
+		return status.Errorf(codes.Unauthenticated, "invalid two-factor code")
+	}
+
+	return nil
+}
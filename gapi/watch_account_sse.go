@@ -0,0 +1,129 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/spaghetti-lover/simplebank/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchAccountPattern is the gateway route of WatchAccount.
+// The in-process gateway cannot serve streaming calls, so WatchAccountHandler takes the route over.
+const WatchAccountPattern = "GET /v1/accounts/{account_id}/watch"
+
+// WatchAccountHandler streams WatchAccount as server-sent events, one data line of JSON per response.
+// Errors before the first response get the usual gateway error response,
+// later ones are sent as an error event before the stream ends.
+// mux is the gateway mux, whose header matcher and marshaler are reused.
+func (server *Server) WatchAccountHandler(mux *runtime.ServeMux) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		_, marshaler := runtime.MarshalerForRequest(mux, req)
+
+		ctx, err := runtime.AnnotateIncomingContext(
+			req.Context(),
+			mux,
+			req,
+			"/pb.SimpleBank/WatchAccount",
+			runtime.WithHTTPPathPattern("/v1/accounts/{account_id}/watch"),
+		)
+		if err != nil {
+			runtime.HTTPError(req.Context(), mux, marshaler, res, req, err)
+			return
+		}
+
+		accountID, err := strconv.ParseInt(req.PathValue("account_id"), 10, 64)
+		if err != nil {
+			err = status.Errorf(codes.InvalidArgument, "invalid account_id: %s", err)
+			runtime.HTTPError(ctx, mux, marshaler, res, req, err)
+			return
+		}
+
+		flusher, ok := res.(http.Flusher)
+		if !ok {
+			err = status.Errorf(codes.Unimplemented, "streaming is not supported by this connection")
+			runtime.HTTPError(ctx, mux, marshaler, res, req, err)
+			return
+		}
+
+		stream := &sseWatchAccountStream{
+			ctx:       ctx,
+			res:       res,
+			flusher:   flusher,
+			marshaler: marshaler,
+		}
+
+		err = server.WatchAccount(&pb.WatchAccountRequest{AccountId: accountID}, stream)
+		if err == nil {
+			return
+		}
+
+		if !stream.started {
+			runtime.HTTPError(ctx, mux, marshaler, res, req, err)
+			return
+		}
+		stream.sendError(err)
+	})
+}
+
+// sseWatchAccountStream writes the responses of WatchAccount as server-sent events.
+// WatchAccount only uses Send and Context, the rest of grpc.ServerStream is left unimplemented.
+type sseWatchAccountStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	res       http.ResponseWriter
+	flusher   http.Flusher
+	marshaler runtime.Marshaler
+	started   bool
+}
+
+func (stream *sseWatchAccountStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *sseWatchAccountStream) Send(rsp *pb.WatchAccountResponse) error {
+	data, err := stream.marshaler.Marshal(rsp)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal response: %s", err)
+	}
+
+	if !stream.started {
+		stream.res.Header().Set("Content-Type", "text/event-stream")
+		stream.res.Header().Set("Cache-Control", "no-cache")
+		stream.res.WriteHeader(http.StatusOK)
+		stream.started = true
+	}
+
+	return stream.write("", data)
+}
+
+func (stream *sseWatchAccountStream) sendError(err error) {
+	data, marshalErr := stream.marshaler.Marshal(status.Convert(err).Proto())
+	if marshalErr != nil {
+		return
+	}
+
+	// the client may be gone already, nothing is left to do if this fails
+	_ = stream.write("error", data)
+}
+
+func (stream *sseWatchAccountStream) write(event string, data []byte) error {
+	var err error
+	if event != "" {
+		_, err = fmt.Fprintf(stream.res, "event: %s\n", event)
+	}
+	if err == nil {
+		_, err = fmt.Fprintf(stream.res, "data: %s\n\n", data)
+	}
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to write event: %s", err)
+	}
+
+	stream.flusher.Flush()
+	return nil
+}
@@ -0,0 +1,90 @@
+package gapi
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	mockdb "github.com/spaghetti-lover/simplebank/db/mock"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchAccountHandler(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	account := randomAccount(user.Username)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+	hub := NewAccountActivityHub()
+	server := newTestServer(t, store, nil)
+	server.accountWatcher = hub
+
+	grpcMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(GatewayHeaderMatcher))
+	mux := http.NewServeMux()
+	mux.Handle("/", grpcMux)
+	mux.Handle(WatchAccountPattern, server.WatchAccountHandler(grpcMux))
+
+	httpServer := httptest.NewServer(HttpLogger(mux))
+	defer httpServer.Close()
+
+	accessToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, uuid.Nil, time.Minute, token.TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	url := fmt.Sprintf("%s/v1/accounts/%d/watch", httpServer.URL, account.ID)
+
+	// without a token the error comes back before the stream starts
+	rsp, err := http.Get(url)
+	require.NoError(t, err)
+	rsp.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+
+	rsp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	require.Equal(t, "text/event-stream", rsp.Header.Get("Content-Type"))
+
+	events := bufio.NewScanner(rsp.Body)
+	nextData := func() map[string]any {
+		for events.Scan() {
+			data, ok := strings.CutPrefix(events.Text(), "data: ")
+			if !ok {
+				continue
+			}
+
+			var event map[string]any
+			require.NoError(t, json.Unmarshal([]byte(data), &event))
+			return event
+		}
+		require.FailNow(t, "stream ended", events.Err())
+		return nil
+	}
+
+	event := nextData()
+	require.Equal(t, fmt.Sprint(account.Balance), event["account"].(map[string]any)["balance"])
+	require.Nil(t, event["entry"])
+
+	activity := randomAccountActivity(account)
+	hub.Publish(activity)
+
+	event = nextData()
+	require.Equal(t, fmt.Sprint(activity.Account.Balance), event["account"].(map[string]any)["balance"])
+	require.Equal(t, fmt.Sprint(activity.Entry.Amount), event["entry"].(map[string]any)["amount"])
+}
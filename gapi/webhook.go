@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/token"
+	"github.com/spaghetti-lover/simplebank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getManageableWebhookSubscription returns the subscription if it belongs to the user of the payload,
+// or if their role can manage the webhooks of any user
+func (server *Server) getManageableWebhookSubscription(
+	ctx context.Context,
+	authPayload *token.Payload,
+	subscriptionID int64,
+) (db.WebhookSubscription, error) {
+	subscription, err := server.store.GetWebhookSubscription(ctx, subscriptionID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return subscription, status.Errorf(codes.NotFound, "webhook subscription [%d] not found", subscriptionID)
+		}
+		return subscription, status.Errorf(codes.Internal, "failed to get webhook subscription: %s", err)
+	}
+
+	if subscription.Owner != authPayload.Username {
+		err = server.checkPermission(ctx, authPayload, util.PermissionWebhooksManageAny)
+		if err != nil {
+			return subscription, err
+		}
+	}
+
+	return subscription, nil
+}
+
+// hostResolver looks up the addresses of a host, it is net.DefaultResolver outside of tests
+type hostResolver interface {
+	LookupNetIP(ctx context.Context, network string, host string) ([]netip.Addr, error)
+}
+
+// checkWebhookURL refuses a webhook URL whose host resolves to an address of our own network.
+// The worker checks the address again when it connects, as the DNS records may change meanwhile.
+func (server *Server) checkWebhookURL(ctx context.Context, webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid webhook url: %s", err)
+	}
+	host := u.Hostname()
+
+	addrs := []netip.Addr{}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addrs = append(addrs, addr)
+	} else {
+		addrs, err = server.resolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("url", fmt.Errorf("cannot resolve host %q", host))})
+		}
+	}
+
+	for _, addr := range addrs {
+		if !util.IsPublicIP(addr) {
+			return invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("url", fmt.Errorf("must not point to a private address"))})
+		}
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spaghetti-lover/simplebank/api"
+	"github.com/spaghetti-lover/simplebank/auth"
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	_ "github.com/spaghetti-lover/simplebank/doc/statik"
 	"github.com/spaghetti-lover/simplebank/gapi"
@@ -59,7 +60,7 @@ func main() {
 
 	runDBMigration(config.MigrationURL, config.DBSource)
 
-	store := db.NewStore(connPool)
+	store := db.NewStore(connPool, worker.DeliverWebhookTask)
 
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.RedisAddress,
@@ -70,8 +71,18 @@ func main() {
 	waitGroup, ctx := errgroup.WithContext(ctx)
 
 	runTaskProcessor(ctx, waitGroup, config, redisOpt, store)
-	runGatewayServer(ctx, waitGroup, config, store, taskDistributor)
-	runGrpcServer(ctx, waitGroup, config, store, taskDistributor)
+	runOutboxRelay(ctx, waitGroup, config, store, taskDistributor)
+	accountWatcher := runAccountActivityListener(ctx, waitGroup, config)
+
+	// both transports share one server, so that forgetting a session or a user
+	// in one of them clears the caches and login attempts seen by the other
+	server, err := gapi.NewServer(config, store, taskDistributor, accountWatcher)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create server")
+	}
+
+	runGatewayServer(ctx, waitGroup, config, server)
+	runGrpcServer(ctx, waitGroup, config, server)
 
 	err = waitGroup.Wait()
 	if err != nil {
@@ -100,7 +111,18 @@ func runTaskProcessor(
 	store db.Store,
 ) {
 	mailer := mail.NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer)
+
+	// webhooks are unavailable without an encryption key
+	var webhookCipher *auth.SecretCipher
+	if config.WebhookEncryptionKey != "" {
+		var err error
+		webhookCipher, err = auth.NewSecretCipher(config.WebhookEncryptionKey)
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot create webhook secret cipher")
+		}
+	}
+
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer, webhookCipher)
 
 	log.Info().Msg("start task processor")
 	err := taskProcessor.Start()
@@ -119,20 +141,49 @@ func runTaskProcessor(
 	})
 }
 
-func runGrpcServer(
+func runOutboxRelay(
 	ctx context.Context,
 	waitGroup *errgroup.Group,
 	config util.Config,
 	store db.Store,
 	taskDistributor worker.TaskDistributor,
 ) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create server")
-	}
+	relay := worker.NewOutboxRelay(store, taskDistributor, config.OutboxRelayInterval)
+
+	log.Info().Msg("start outbox relay")
+	waitGroup.Go(func() error {
+		err := relay.Run(ctx)
+		log.Info().Msg("outbox relay is stopped")
+		return err
+	})
+}
 
-	gprcLogger := grpc.UnaryInterceptor(gapi.GrpcLogger)
-	grpcServer := grpc.NewServer(gprcLogger)
+func runAccountActivityListener(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+) gapi.AccountWatcher {
+	hub := gapi.NewAccountActivityHub()
+
+	log.Info().Msg("start account activity listener")
+	waitGroup.Go(func() error {
+		err := hub.Listen(ctx, config.DBSource)
+		log.Info().Msg("account activity listener is stopped")
+		return err
+	})
+
+	return hub
+}
+
+func runGrpcServer(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	server *gapi.Server,
+) {
+	interceptors := grpc.ChainUnaryInterceptor(gapi.GrpcLogger, server.AuthorizationInterceptor)
+	streamInterceptors := grpc.ChainStreamInterceptor(server.AuthorizationStreamInterceptor)
+	grpcServer := grpc.NewServer(interceptors, streamInterceptors)
 	pb.RegisterSimpleBankServer(grpcServer, server)
 	reflection.Register(grpcServer)
 
@@ -171,14 +222,8 @@ func runGatewayServer(
 	ctx context.Context,
 	waitGroup *errgroup.Group,
 	config util.Config,
-	store db.Store,
-	taskDistributor worker.TaskDistributor,
+	server *gapi.Server,
 ) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create server")
-	}
-
 	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 		MarshalOptions: protojson.MarshalOptions{
 			UseProtoNames: true,
@@ -188,15 +233,19 @@ func runGatewayServer(
 		},
 	})
 
-	grpcMux := runtime.NewServeMux(jsonOption)
+	headerMatcher := runtime.WithIncomingHeaderMatcher(gapi.GatewayHeaderMatcher)
+
+	grpcMux := runtime.NewServeMux(jsonOption, headerMatcher)
 
-	err = pb.RegisterSimpleBankHandlerServer(ctx, grpcMux, server)
+	err := pb.RegisterSimpleBankHandlerServer(ctx, grpcMux, server)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot register handler server")
 	}
 
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
+	mux.Handle(gapi.JWKSPath, server.JWKSHandler())
+	mux.Handle(gapi.WatchAccountPattern, server.WatchAccountHandler(grpcMux))
 
 	statikFS, err := fs.New()
 	if err != nil {
@@ -220,6 +269,7 @@ func runGatewayServer(
 		AllowedHeaders: []string{
 			"Content-Type",
 			"Authorization",
+			"Idempotency-Key",
 		},
 		AllowCredentials: true,
 	})
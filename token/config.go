@@ -0,0 +1,76 @@
+package token
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+// Supported values of the TOKEN_MAKER setting
+const (
+	PasetoV2Local  = "paseto.v2.local"
+	PasetoV4Public = "paseto.v4.public"
+	JWTHS256       = "jwt.hs256"
+	JWTRS256       = "jwt.rs256"
+	JWTES256       = "jwt.es256"
+)
+
+// NewMakerFromConfig creates the token maker selected by the configuration.
+// The symmetric PASETO maker is used when none is selected.
+func NewMakerFromConfig(config util.Config) (Maker, error) {
+	switch config.TokenMaker {
+	case "", PasetoV2Local:
+		return NewPasetoMaker(config.TokenSymmetricKey)
+	case PasetoV4Public:
+		verificationKeys, err := ParseVerificationKeys(config.TokenVerificationKeys)
+		if err != nil {
+			return nil, err
+		}
+		maker, err := NewPasetoPublicMaker(config.TokenSigningKeyID, config.TokenSigningKey, verificationKeys)
+		if err != nil {
+			return nil, err
+		}
+		return maker, nil
+	case JWTHS256:
+		maker, err := newHMACJWTMaker(config.TokenSymmetricKey, config.TokenIssuer, config.TokenAudience)
+		if err != nil {
+			return nil, err
+		}
+		return maker, nil
+	case JWTRS256, JWTES256:
+		// TOKEN_SIGNING_KEY holds the PEM-encoded private key
+		algorithm := strings.ToUpper(strings.TrimPrefix(config.TokenMaker, "jwt."))
+		maker, err := NewAsymmetricJWTMaker(algorithm, config.TokenSigningKeyID, config.TokenSigningKey, config.TokenIssuer, config.TokenAudience)
+		if err != nil {
+			return nil, err
+		}
+		return maker, nil
+	default:
+		return nil, fmt.Errorf("unsupported token maker: %s", config.TokenMaker)
+	}
+}
+
+// ParseVerificationKeys parses keys written as "<key ID>:<hex-encoded public key>"
+func ParseVerificationKeys(keys []string) (map[string]string, error) {
+	verificationKeys := make(map[string]string, len(keys))
+
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+
+		keyID, publicKey, ok := strings.Cut(key, ":")
+		if !ok || keyID == "" || publicKey == "" {
+			return nil, fmt.Errorf("invalid verification key %q: must be <key ID>:<public key>", key)
+		}
+		if _, exists := verificationKeys[keyID]; exists {
+			return nil, fmt.Errorf("duplicate verification key ID %q", keyID)
+		}
+
+		verificationKeys[keyID] = publicKey
+	}
+
+	return verificationKeys, nil
+}
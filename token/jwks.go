@@ -0,0 +1,57 @@
+package token
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+)
+
+// JSONWebKey is a public key in the JWK format (RFC 7517)
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
+// JSONWebKeySet is the document served at /.well-known/jwks.json
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// KeySetProvider is implemented by token makers whose tokens can be verified with public keys
+type KeySetProvider interface {
+	// KeySet returns the keys needed to verify the tokens of the maker
+	KeySet() JSONWebKeySet
+}
+
+func newRSAWebKey(keyID string, algorithm string, key *rsa.PublicKey) JSONWebKey {
+	return JSONWebKey{
+		KeyType:   "RSA",
+		KeyID:     keyID,
+		Use:       "sig",
+		Algorithm: algorithm,
+		N:         base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func newECWebKey(keyID string, algorithm string, key *ecdsa.PublicKey) JSONWebKey {
+	size := (key.Curve.Params().BitSize + 7) / 8
+
+	return JSONWebKey{
+		KeyType:   "EC",
+		KeyID:     keyID,
+		Use:       "sig",
+		Algorithm: algorithm,
+		Curve:     key.Curve.Params().Name,
+		X:         base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, size))),
+		Y:         base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, size))),
+	}
+}
@@ -0,0 +1,169 @@
+package token
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomRSAKeyPEM(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func randomECKeyPEM(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+}
+
+// publicKeyFromJWK rebuilds the key the way a downstream service would
+func publicKeyFromJWK(t *testing.T, key JSONWebKey) interface{} {
+	decode := func(s string) *big.Int {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		require.NoError(t, err)
+		return new(big.Int).SetBytes(b)
+	}
+
+	switch key.KeyType {
+	case "RSA":
+		return &rsa.PublicKey{N: decode(key.N), E: int(decode(key.E).Int64())}
+	case "EC":
+		require.Equal(t, "P-256", key.Curve)
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: decode(key.X), Y: decode(key.Y)}
+	}
+
+	t.Fatalf("unexpected key type %s", key.KeyType)
+	return nil
+}
+
+func TestAsymmetricJWTMaker(t *testing.T) {
+	testCases := []struct {
+		algorithm  string
+		privateKey string
+	}{
+		{algorithm: "RS256", privateKey: randomRSAKeyPEM(t)},
+		{algorithm: "ES256", privateKey: randomECKeyPEM(t)},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.algorithm, func(t *testing.T) {
+			maker, err := NewAsymmetricJWTMaker(tc.algorithm, "key-1", tc.privateKey, "simplebank", "partners")
+			require.NoError(t, err)
+
+			username := util.RandomOwner()
+			sessionID := uuid.New()
+
+			token, payload, err := maker.CreateToken(username, util.DepositorRole, sessionID, time.Minute, TokenTypeAccessToken)
+			require.NoError(t, err)
+			require.Equal(t, "simplebank", payload.Issuer)
+			require.Equal(t, []string{"partners"}, payload.Audience)
+
+			payload, err = maker.VerifyToken(token, TokenTypeAccessToken)
+			require.NoError(t, err)
+			require.Equal(t, username, payload.Username)
+			require.Equal(t, sessionID, payload.SessionID)
+
+			subject, err := payload.GetSubject()
+			require.NoError(t, err)
+			require.Equal(t, username, subject)
+
+			// any JWT library can verify the token with the published key
+			keySet := maker.KeySet()
+			require.Len(t, keySet.Keys, 1)
+			require.Equal(t, "key-1", keySet.Keys[0].KeyID)
+			require.Equal(t, tc.algorithm, keySet.Keys[0].Algorithm)
+
+			claims := jwt.MapClaims{}
+			_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+				require.Equal(t, "key-1", token.Header["kid"])
+				return publicKeyFromJWK(t, keySet.Keys[0]), nil
+			}, jwt.WithValidMethods([]string{tc.algorithm}), jwt.WithIssuer("simplebank"), jwt.WithAudience("partners"), jwt.WithExpirationRequired())
+			require.NoError(t, err)
+			require.Equal(t, username, claims["sub"])
+			require.Equal(t, payload.ID.String(), claims["jti"])
+
+			// tokens for another audience are rejected
+			otherMaker, err := NewAsymmetricJWTMaker(tc.algorithm, "key-1", tc.privateKey, "simplebank", "someone-else")
+			require.NoError(t, err)
+
+			payload, err = otherMaker.VerifyToken(token, TokenTypeAccessToken)
+			require.EqualError(t, err, ErrInvalidToken.Error())
+			require.Nil(t, payload)
+		})
+	}
+}
+
+func TestExpiredAsymmetricJWTToken(t *testing.T) {
+	maker, err := NewAsymmetricJWTMaker("ES256", "key-1", randomECKeyPEM(t), "", "")
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, -time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token, TokenTypeAccessToken)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestAsymmetricJWTWrongKey(t *testing.T) {
+	maker, err := NewAsymmetricJWTMaker("ES256", "key-1", randomECKeyPEM(t), "", "")
+	require.NoError(t, err)
+
+	otherMaker, err := NewAsymmetricJWTMaker("ES256", "key-1", randomECKeyPEM(t), "", "")
+	require.NoError(t, err)
+
+	token, _, err := otherMaker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token, TokenTypeAccessToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+
+	// an HS256 token is never accepted by an asymmetric maker
+	hmacMaker, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, _, err = hmacMaker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	payload, err = maker.VerifyToken(token, TokenTypeAccessToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestInvalidAsymmetricJWTMaker(t *testing.T) {
+	_, err := NewAsymmetricJWTMaker("RS256", "key-1", randomECKeyPEM(t), "", "")
+	require.Error(t, err)
+
+	_, err = NewAsymmetricJWTMaker("ES256", "", randomECKeyPEM(t), "", "")
+	require.Error(t, err)
+
+	_, err = NewAsymmetricJWTMaker("HS256", "key-1", util.RandomString(32), "", "")
+	require.Error(t, err)
+
+	maker, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+	require.Empty(t, maker.(KeySetProvider).KeySet().Keys)
+}
@@ -1,51 +1,151 @@
 package token
 
 import (
+	"crypto/ecdsa"
+	"crypto/rsa"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 const minSecretKeySize = 32
 
 // JWTMaker is a JSON Web Token maker
 type JWTMaker struct {
-	secretKey string
+	method          jwt.SigningMethod
+	keyID           string
+	signingKey      interface{}
+	verificationKey interface{}
+	issuer          string
+	audience        string
 }
 
-// NewJWTMaker creates a new JWTMaker
+// jwtClaims adds the registered JWT claims to the payload,
+// so that other services can verify our tokens with any JWT library
+type jwtClaims struct {
+	*Payload
+	Subject        string           `json:"sub"`
+	JWTID          string           `json:"jti"`
+	ExpirationTime *jwt.NumericDate `json:"exp"`
+	NotBeforeTime  *jwt.NumericDate `json:"nbf"`
+	IssuedAtTime   *jwt.NumericDate `json:"iat"`
+}
+
+// NewJWTMaker creates a new JWTMaker signing HS256 tokens with a shared secret
 func NewJWTMaker(secretKey string) (Maker, error) {
+	return newHMACJWTMaker(secretKey, "", "")
+}
+
+func newHMACJWTMaker(secretKey string, issuer string, audience string) (*JWTMaker, error) {
 	if len(secretKey) < minSecretKeySize {
 		return nil, fmt.Errorf("invalid key size: must be at least %d characters", minSecretKeySize)
 	}
-	return &JWTMaker{secretKey}, nil
+
+	maker := &JWTMaker{
+		method:          jwt.SigningMethodHS256,
+		signingKey:      []byte(secretKey),
+		verificationKey: []byte(secretKey),
+		issuer:          issuer,
+		audience:        audience,
+	}
+	return maker, nil
+}
+
+// NewAsymmetricJWTMaker creates a new JWTMaker signing RS256 or ES256 tokens
+// with a PEM-encoded private key. The key ID is sent in the "kid" header.
+func NewAsymmetricJWTMaker(algorithm string, keyID string, privateKeyPEM string, issuer string, audience string) (*JWTMaker, error) {
+	maker := &JWTMaker{
+		keyID:    keyID,
+		issuer:   issuer,
+		audience: audience,
+	}
+
+	switch algorithm {
+	case jwt.SigningMethodRS256.Alg():
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA private key: %w", err)
+		}
+		maker.method = jwt.SigningMethodRS256
+		maker.signingKey = privateKey
+		maker.verificationKey = &privateKey.PublicKey
+	case jwt.SigningMethodES256.Alg():
+		privateKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(privateKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid EC private key: %w", err)
+		}
+		if privateKey.Curve.Params().Name != "P-256" {
+			return nil, fmt.Errorf("invalid EC private key: ES256 requires a P-256 key")
+		}
+		maker.method = jwt.SigningMethodES256
+		maker.signingKey = privateKey
+		maker.verificationKey = &privateKey.PublicKey
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+
+	if keyID == "" {
+		return nil, fmt.Errorf("missing signing key ID")
+	}
+
+	return maker, nil
 }
 
 // CreateToken creates a new token for a specific username and duration
-func (maker *JWTMaker) CreateToken(username string, role string, duration time.Duration, tokenType TokenType) (string, *Payload, error) {
-	payload, err := NewPayload(username, role, duration, tokenType)
+func (maker *JWTMaker) CreateToken(username string, role string, sessionID uuid.UUID, duration time.Duration, tokenType TokenType) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, sessionID, duration, tokenType)
 	if err != nil {
 		return "", payload, err
 	}
 
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
-	token, err := jwtToken.SignedString([]byte(maker.secretKey))
+	payload.Issuer = maker.issuer
+	if maker.audience != "" {
+		payload.Audience = []string{maker.audience}
+	}
+
+	claims := &jwtClaims{
+		Payload:        payload,
+		Subject:        payload.Username,
+		JWTID:          payload.ID.String(),
+		ExpirationTime: jwt.NewNumericDate(payload.ExpiredAt),
+		NotBeforeTime:  jwt.NewNumericDate(payload.IssuedAt),
+		IssuedAtTime:   jwt.NewNumericDate(payload.IssuedAt),
+	}
+
+	jwtToken := jwt.NewWithClaims(maker.method, claims)
+	if maker.keyID != "" {
+		jwtToken.Header["kid"] = maker.keyID
+	}
+
+	token, err := jwtToken.SignedString(maker.signingKey)
 	return token, payload, err
 }
 
 // VerifyToken checks if the token is valid or not
 func (maker *JWTMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
 	keyFunc := func(token *jwt.Token) (interface{}, error) {
-		_, ok := token.Method.(*jwt.SigningMethodHMAC)
-		if !ok {
+		if token.Method.Alg() != maker.method.Alg() {
 			return nil, ErrInvalidToken
 		}
-		return []byte(maker.secretKey), nil
+		if kid, ok := token.Header["kid"]; ok && kid != maker.keyID {
+			return nil, ErrInvalidToken
+		}
+		return maker.verificationKey, nil
+	}
+
+	options := []jwt.ParserOption{jwt.WithValidMethods([]string{maker.method.Alg()})}
+	if maker.issuer != "" {
+		options = append(options, jwt.WithIssuer(maker.issuer))
+	}
+	if maker.audience != "" {
+		options = append(options, jwt.WithAudience(maker.audience))
 	}
 
-	jwtToken, err := jwt.ParseWithClaims(token, &Payload{}, keyFunc)
+	claims := &jwtClaims{Payload: &Payload{}}
+	_, err := jwt.ParseWithClaims(token, claims, keyFunc, options...)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, ErrExpiredToken
@@ -53,11 +153,7 @@ func (maker *JWTMaker) VerifyToken(token string, tokenType TokenType) (*Payload,
 		return nil, ErrInvalidToken
 	}
 
-	payload, ok := jwtToken.Claims.(*Payload)
-	if !ok {
-		return nil, ErrInvalidToken
-	}
-
+	payload := claims.Payload
 	err = payload.Valid(tokenType)
 	if err != nil {
 		return nil, err
@@ -65,3 +161,18 @@ func (maker *JWTMaker) VerifyToken(token string, tokenType TokenType) (*Payload,
 
 	return payload, nil
 }
+
+// KeySet returns the public key of an asymmetric maker.
+// The key set is empty for makers using a shared secret.
+func (maker *JWTMaker) KeySet() JSONWebKeySet {
+	keySet := JSONWebKeySet{Keys: []JSONWebKey{}}
+
+	switch key := maker.verificationKey.(type) {
+	case *rsa.PublicKey:
+		keySet.Keys = append(keySet.Keys, newRSAWebKey(maker.keyID, maker.method.Alg(), key))
+	case *ecdsa.PublicKey:
+		keySet.Keys = append(keySet.Keys, newECWebKey(maker.keyID, maker.method.Alg(), key))
+	}
+
+	return keySet
+}
@@ -2,12 +2,15 @@ package token
 
 import (
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // Maker is an interface for managing tokens
 type Maker interface {
-	// CreateToken creates a new token for a specific username and duration
-	CreateToken(username string, role string, duration time.Duration, tokenType TokenType) (string, *Payload, error)
+	// CreateToken creates a new token for a specific username and duration,
+	// bound to the session with the given ID
+	CreateToken(username string, role string, sessionID uuid.UUID, duration time.Duration, tokenType TokenType) (string, *Payload, error)
 
 	// VerifyToken checks if the token is valid or not
 	VerifyToken(token string, tokenType TokenType) (*Payload, error)
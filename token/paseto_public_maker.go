@@ -0,0 +1,224 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const pasetoPublicHeader = "v4.public."
+
+// PasetoPublicMaker is a PASETO v4.public token maker.
+// Tokens are signed with Ed25519 and carry the ID of the signing key in their footer,
+// so they can be verified by anyone holding the public keys.
+type PasetoPublicMaker struct {
+	mu               sync.RWMutex
+	signingKeyID     string
+	signingKey       ed25519.PrivateKey
+	verificationKeys map[string]ed25519.PublicKey
+}
+
+type pasetoFooter struct {
+	KeyID string `json:"kid"`
+}
+
+// NewPasetoPublicMaker creates a new PasetoPublicMaker.
+// signingKey is the hex-encoded Ed25519 seed; its public key is always accepted for verification,
+// together with verificationKeys, which map key IDs to hex-encoded public keys.
+// Without a signing key the maker can only verify tokens.
+func NewPasetoPublicMaker(signingKeyID string, signingKey string, verificationKeys map[string]string) (*PasetoPublicMaker, error) {
+	maker := &PasetoPublicMaker{
+		verificationKeys: make(map[string]ed25519.PublicKey),
+	}
+
+	for keyID, key := range verificationKeys {
+		publicKey, err := parsePublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verification key %q: %w", keyID, err)
+		}
+		maker.verificationKeys[keyID] = publicKey
+	}
+
+	if signingKeyID == "" && signingKey == "" {
+		if len(maker.verificationKeys) == 0 {
+			return nil, fmt.Errorf("missing signing or verification keys")
+		}
+		return maker, nil
+	}
+
+	err := maker.RotateSigningKey(signingKeyID, signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return maker, nil
+}
+
+// RotateSigningKey makes the maker sign new tokens with another key.
+// Tokens signed with the previous key stay valid until they expire.
+func (maker *PasetoPublicMaker) RotateSigningKey(keyID string, key string) error {
+	if keyID == "" {
+		return fmt.Errorf("missing signing key ID")
+	}
+
+	privateKey, err := parsePrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("invalid signing key: %w", err)
+	}
+
+	maker.mu.Lock()
+	defer maker.mu.Unlock()
+
+	if publicKey, ok := maker.verificationKeys[keyID]; ok && !publicKey.Equal(privateKey.Public()) {
+		return fmt.Errorf("key ID %q is already used by another key", keyID)
+	}
+
+	maker.signingKeyID = keyID
+	maker.signingKey = privateKey
+	maker.verificationKeys[keyID] = privateKey.Public().(ed25519.PublicKey)
+	return nil
+}
+
+// RemoveVerificationKey stops accepting tokens signed with the key.
+// The current signing key cannot be removed.
+func (maker *PasetoPublicMaker) RemoveVerificationKey(keyID string) error {
+	maker.mu.Lock()
+	defer maker.mu.Unlock()
+
+	if keyID == maker.signingKeyID {
+		return fmt.Errorf("cannot remove the signing key %q", keyID)
+	}
+
+	delete(maker.verificationKeys, keyID)
+	return nil
+}
+
+// CreateToken creates a new token for a specific username and duration
+func (maker *PasetoPublicMaker) CreateToken(username string, role string, sessionID uuid.UUID, duration time.Duration, tokenType TokenType) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, sessionID, duration, tokenType)
+	if err != nil {
+		return "", payload, err
+	}
+
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return "", payload, err
+	}
+
+	maker.mu.RLock()
+	keyID, privateKey := maker.signingKeyID, maker.signingKey
+	maker.mu.RUnlock()
+
+	if privateKey == nil {
+		return "", payload, fmt.Errorf("maker has no signing key")
+	}
+
+	footer, err := json.Marshal(pasetoFooter{KeyID: keyID})
+	if err != nil {
+		return "", payload, err
+	}
+
+	signature := ed25519.Sign(privateKey, preAuthEncode([]byte(pasetoPublicHeader), message, footer, nil))
+
+	token := pasetoPublicHeader +
+		base64.RawURLEncoding.EncodeToString(append(message, signature...)) + "." +
+		base64.RawURLEncoding.EncodeToString(footer)
+	return token, payload, nil
+}
+
+// VerifyToken checks if the token is valid or not
+func (maker *PasetoPublicMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
+	if !strings.HasPrefix(token, pasetoPublicHeader) {
+		return nil, ErrInvalidToken
+	}
+
+	parts := strings.Split(strings.TrimPrefix(token, pasetoPublicHeader), ".")
+	if len(parts) != 2 {
+		return nil, ErrInvalidToken
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || len(body) < ed25519.SignatureSize {
+		return nil, ErrInvalidToken
+	}
+
+	footer, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var f pasetoFooter
+	if err := json.Unmarshal(footer, &f); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	maker.mu.RLock()
+	publicKey, ok := maker.verificationKeys[f.KeyID]
+	maker.mu.RUnlock()
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	message := body[:len(body)-ed25519.SignatureSize]
+	signature := body[len(body)-ed25519.SignatureSize:]
+	if !ed25519.Verify(publicKey, preAuthEncode([]byte(pasetoPublicHeader), message, footer, nil), signature) {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{}
+	if err := json.Unmarshal(message, payload); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	err = payload.Valid(tokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// preAuthEncode implements PAE from the PASETO specification
+func preAuthEncode(pieces ...[]byte) []byte {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, uint64(len(pieces)))
+
+	for _, piece := range pieces {
+		length := make([]byte, 8)
+		binary.LittleEndian.PutUint64(length, uint64(len(piece)))
+		buf = append(buf, length...)
+		buf = append(buf, piece...)
+	}
+
+	return buf
+}
+
+func parsePrivateKey(key string) (ed25519.PrivateKey, error) {
+	seed, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("must be exactly %d hex-encoded bytes", ed25519.SeedSize)
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+func parsePublicKey(key string) (ed25519.PublicKey, error) {
+	publicKey, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("must be exactly %d hex-encoded bytes", ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(publicKey), nil
+}
@@ -0,0 +1,225 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomSigningKey(t *testing.T) (privateKey string, publicKey string) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return hex.EncodeToString(private.Seed()), hex.EncodeToString(public)
+}
+
+func TestPasetoPublicMaker(t *testing.T) {
+	privateKey, _ := randomSigningKey(t)
+	maker, err := NewPasetoPublicMaker("key-1", privateKey, nil)
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	role := util.DepositorRole
+	sessionID := uuid.New()
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(username, role, sessionID, duration, TokenTypeAccessToken)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(token, "v4.public."))
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	require.NotZero(t, payload.ID)
+	require.Equal(t, username, payload.Username)
+	require.Equal(t, role, payload.Role)
+	require.Equal(t, sessionID, payload.SessionID)
+	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+}
+
+func TestExpiredPasetoPublicToken(t *testing.T) {
+	privateKey, _ := randomSigningKey(t)
+	maker, err := NewPasetoPublicMaker("key-1", privateKey, nil)
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, -time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token, TokenTypeAccessToken)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestPasetoPublicWrongTokenType(t *testing.T) {
+	privateKey, _ := randomSigningKey(t)
+	maker, err := NewPasetoPublicMaker("key-1", privateKey, nil)
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token, TokenTypeRefreshToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestTamperedPasetoPublicToken(t *testing.T) {
+	privateKey, _ := randomSigningKey(t)
+	maker, err := NewPasetoPublicMaker("key-1", privateKey, nil)
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+	body, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	body[0] ^= 1
+	parts[2] = base64.RawURLEncoding.EncodeToString(body)
+
+	payload, err := maker.VerifyToken(strings.Join(parts, "."), TokenTypeAccessToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+
+	// a token signed by an unknown key is rejected as well
+	otherKey, _ := randomSigningKey(t)
+	otherMaker, err := NewPasetoPublicMaker("key-1", otherKey, nil)
+	require.NoError(t, err)
+
+	token, _, err = otherMaker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	payload, err = maker.VerifyToken(token, TokenTypeAccessToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestPasetoPublicKeyRotation(t *testing.T) {
+	oldKey, oldPublicKey := randomSigningKey(t)
+	maker, err := NewPasetoPublicMaker("key-1", oldKey, nil)
+	require.NoError(t, err)
+
+	oldToken, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	newKey, newPublicKey := randomSigningKey(t)
+	require.NoError(t, maker.RotateSigningKey("key-2", newKey))
+
+	newToken, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	// tokens of both keys are accepted after the rotation
+	_, err = maker.VerifyToken(oldToken, TokenTypeAccessToken)
+	require.NoError(t, err)
+	_, err = maker.VerifyToken(newToken, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	// a verifier only needs the public keys
+	verifier, err := NewPasetoPublicMaker("", "", map[string]string{
+		"key-1": oldPublicKey,
+		"key-2": newPublicKey,
+	})
+	require.NoError(t, err)
+
+	_, err = verifier.VerifyToken(oldToken, TokenTypeAccessToken)
+	require.NoError(t, err)
+	_, err = verifier.VerifyToken(newToken, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	_, _, err = verifier.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.Nil, time.Minute, TokenTypeAccessToken)
+	require.Error(t, err)
+
+	// once the old key is retired its tokens are rejected
+	require.Error(t, maker.RemoveVerificationKey("key-2"))
+	require.NoError(t, maker.RemoveVerificationKey("key-1"))
+
+	_, err = maker.VerifyToken(oldToken, TokenTypeAccessToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	_, err = maker.VerifyToken(newToken, TokenTypeAccessToken)
+	require.NoError(t, err)
+}
+
+func TestPasetoPublicInvalidKeys(t *testing.T) {
+	privateKey, _ := randomSigningKey(t)
+	otherKey, otherPublicKey := randomSigningKey(t)
+
+	_, err := NewPasetoPublicMaker("", privateKey, nil)
+	require.Error(t, err)
+
+	_, err = NewPasetoPublicMaker("key-1", privateKey[:10], nil)
+	require.Error(t, err)
+
+	_, err = NewPasetoPublicMaker("key-1", privateKey, map[string]string{"key-2": "invalid"})
+	require.Error(t, err)
+
+	_, err = NewPasetoPublicMaker("", "", nil)
+	require.Error(t, err)
+
+	// a key ID cannot be reused for a different key
+	maker, err := NewPasetoPublicMaker("key-1", privateKey, map[string]string{"key-2": otherPublicKey})
+	require.NoError(t, err)
+	require.Error(t, maker.RotateSigningKey("key-1", otherKey))
+	require.NoError(t, maker.RotateSigningKey("key-2", otherKey))
+}
+
+// Test vector 4-S-1 from the PASETO specification
+func TestPasetoPublicSpecVector(t *testing.T) {
+	seed, err := hex.DecodeString("b4cbfb43df4ce210727d953e4a713307fa19bb7d9f85041438d9e11b942a3774")
+	require.NoError(t, err)
+
+	message := []byte(`{"data":"this is a signed message","exp":"2022-01-01T00:00:00+00:00"}`)
+	signature := ed25519.Sign(ed25519.NewKeyFromSeed(seed), preAuthEncode([]byte(pasetoPublicHeader), message, nil, nil))
+
+	token := pasetoPublicHeader + base64.RawURLEncoding.EncodeToString(append(message, signature...))
+	require.Equal(t, "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9bg_XBBzds8lTZShVlwwKSgeKpLT3yukTw6JUz3W4h_ExsQV-P0V54zemZDcAxFaSeef1QlXEFtkqxT1ciiQEDA", token)
+}
+
+func TestNewMakerFromConfig(t *testing.T) {
+	privateKey, _ := randomSigningKey(t)
+	_, oldPublicKey := randomSigningKey(t)
+
+	maker, err := NewMakerFromConfig(util.Config{TokenSymmetricKey: util.RandomString(32)})
+	require.NoError(t, err)
+	require.IsType(t, &PasetoMaker{}, maker)
+
+	maker, err = NewMakerFromConfig(util.Config{
+		TokenMaker:            PasetoV4Public,
+		TokenSigningKeyID:     "key-2",
+		TokenSigningKey:       privateKey,
+		TokenVerificationKeys: []string{"key-1:" + oldPublicKey},
+	})
+	require.NoError(t, err)
+	require.IsType(t, &PasetoPublicMaker{}, maker)
+
+	_, err = NewMakerFromConfig(util.Config{
+		TokenMaker:            PasetoV4Public,
+		TokenSigningKeyID:     "key-2",
+		TokenSigningKey:       privateKey,
+		TokenVerificationKeys: []string{oldPublicKey},
+	})
+	require.Error(t, err)
+
+	maker, err = NewMakerFromConfig(util.Config{
+		TokenMaker:        JWTES256,
+		TokenSigningKeyID: "key-1",
+		TokenSigningKey:   randomECKeyPEM(t),
+		TokenIssuer:       "simplebank",
+	})
+	require.NoError(t, err)
+	require.IsType(t, &JWTMaker{}, maker)
+
+	_, err = NewMakerFromConfig(util.Config{TokenMaker: "unknown"})
+	require.Error(t, err)
+}
@@ -19,27 +19,38 @@ type TokenType byte
 const (
 	TokenTypeAccessToken  = 1
 	TokenTypeRefreshToken = 2
+	// TokenTypeMFAToken proves the password was checked while the second factor is pending
+	TokenTypeMFAToken = 3
 )
 
 // Payload contains the payload data of the token
 type Payload struct {
 	ID        uuid.UUID `json:"id"`
+	SessionID uuid.UUID `json:"session_id"`
 	Type      TokenType `json:"token_type"`
 	Username  string    `json:"username"`
 	Role      string    `json:"role"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
+	Issuer    string    `json:"iss,omitempty"`
+	Audience  []string  `json:"aud,omitempty"`
 }
 
-// NewPayload creates a new token payload with a specific username and duration
-func NewPayload(username string, role string, duration time.Duration, tokenType TokenType) (*Payload, error) {
+// NewPayload creates a new token payload with a specific username and duration.
+// A nil sessionID means the token starts a new session, identified by the token ID.
+func NewPayload(username string, role string, sessionID uuid.UUID, duration time.Duration, tokenType TokenType) (*Payload, error) {
 	tokenID, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
 	}
 
+	if sessionID == uuid.Nil {
+		sessionID = tokenID
+	}
+
 	payload := &Payload{
 		ID:        tokenID,
+		SessionID: sessionID,
 		Type:      tokenType,
 		Username:  username,
 		Role:      role,
@@ -79,13 +90,13 @@ func (payload *Payload) GetNotBefore() (*jwt.NumericDate, error) {
 }
 
 func (payload *Payload) GetIssuer() (string, error) {
-	return "", nil
+	return payload.Issuer, nil
 }
 
 func (payload *Payload) GetSubject() (string, error) {
-	return "", nil
+	return payload.Username, nil
 }
 
 func (payload *Payload) GetAudience() (jwt.ClaimStrings, error) {
-	return jwt.ClaimStrings{}, nil
+	return jwt.ClaimStrings(payload.Audience), nil
 }
@@ -0,0 +1,25 @@
+package util
+
+// Constants for all account statuses
+const (
+	AccountStatusActive = "active"
+	AccountStatusFrozen = "frozen"
+	AccountStatusClosed = "closed"
+)
+
+// accountStatusTransitions lists the statuses an account can move to from each status
+var accountStatusTransitions = map[string][]string{
+	AccountStatusActive: {AccountStatusFrozen, AccountStatusClosed},
+	AccountStatusFrozen: {AccountStatusActive, AccountStatusClosed},
+	AccountStatusClosed: {AccountStatusActive, AccountStatusFrozen},
+}
+
+// IsAllowedAccountStatusTransition reports whether an account can move from one status to the other
+func IsAllowedAccountStatusTransition(from string, to string) bool {
+	for _, status := range accountStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
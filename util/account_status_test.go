@@ -0,0 +1,20 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAllowedAccountStatusTransition(t *testing.T) {
+	require.True(t, IsAllowedAccountStatusTransition(AccountStatusActive, AccountStatusFrozen))
+	require.True(t, IsAllowedAccountStatusTransition(AccountStatusFrozen, AccountStatusActive))
+	require.True(t, IsAllowedAccountStatusTransition(AccountStatusActive, AccountStatusClosed))
+	require.True(t, IsAllowedAccountStatusTransition(AccountStatusFrozen, AccountStatusClosed))
+	require.True(t, IsAllowedAccountStatusTransition(AccountStatusClosed, AccountStatusActive))
+	require.True(t, IsAllowedAccountStatusTransition(AccountStatusClosed, AccountStatusFrozen))
+
+	require.False(t, IsAllowedAccountStatusTransition(AccountStatusActive, AccountStatusActive))
+	require.False(t, IsAllowedAccountStatusTransition(AccountStatusClosed, AccountStatusClosed))
+	require.False(t, IsAllowedAccountStatusTransition("unknown", AccountStatusActive))
+}
@@ -0,0 +1,56 @@
+package util
+
+// Actions recorded in the audit log
+const (
+	AuditActionUserCreate            = "user.create"
+	AuditActionUserUpdate            = "user.update"
+	AuditActionUserLogin             = "user.login"
+	AuditActionUserLoginFailed       = "user.login_failed"
+	AuditActionUserLockout           = "user.lockout"
+	AuditActionUserEnableTwoFactor   = "user.enable_two_factor"
+	AuditActionUserDisableTwoFactor  = "user.disable_two_factor"
+	AuditActionUserRevokeAllSessions = "user.revoke_all_sessions"
+	AuditActionUserVerifyEmail       = "user.verify_email"
+	AuditActionUserResetPassword     = "user.reset_password"
+	AuditActionUserAssignRole        = "user.assign_role"
+	AuditActionTransferCreate        = "transfer.create"
+	AuditActionAccountAdjust         = "account.adjust"
+	AuditActionAccountChangeStatus   = "account.change_status"
+	AuditActionAccountClose          = "account.close"
+	AuditActionSessionLogout         = "session.logout"
+	AuditActionSessionRevoke         = "session.revoke"
+	AuditActionExchangeRateSet       = "exchange_rate.set"
+)
+
+// Kinds of records an audit event can target
+const (
+	AuditTargetUser         = "user"
+	AuditTargetAccount      = "account"
+	AuditTargetTransfer     = "transfer"
+	AuditTargetSession      = "session"
+	AuditTargetExchangeRate = "exchange_rate"
+)
+
+// IsAuditAction returns true if the action is recorded in the audit log
+func IsAuditAction(action string) bool {
+	switch action {
+	case AuditActionUserCreate, AuditActionUserUpdate, AuditActionUserLogin,
+		AuditActionUserLoginFailed, AuditActionUserLockout, AuditActionUserEnableTwoFactor,
+		AuditActionUserDisableTwoFactor, AuditActionUserRevokeAllSessions,
+		AuditActionUserVerifyEmail, AuditActionUserResetPassword, AuditActionUserAssignRole,
+		AuditActionSessionLogout, AuditActionSessionRevoke,
+		AuditActionTransferCreate, AuditActionAccountAdjust, AuditActionAccountChangeStatus,
+		AuditActionAccountClose, AuditActionExchangeRateSet:
+		return true
+	}
+	return false
+}
+
+// IsAuditTarget returns true if audit events can target the kind of record
+func IsAuditTarget(targetType string) bool {
+	switch targetType {
+	case AuditTargetUser, AuditTargetAccount, AuditTargetTransfer, AuditTargetSession, AuditTargetExchangeRate:
+		return true
+	}
+	return false
+}
@@ -9,19 +9,47 @@ import (
 // Config stores all configuration of the application.
 // The values are read by viper from a config file or environment variable.
 type Config struct {
-	Environment          string        `mapstructure:"ENVIRONMENT"`
-	AllowedOrigins       []string      `mapstructure:"ALLOWED_ORIGINS"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
-	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
-	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
-	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	Environment           string        `mapstructure:"ENVIRONMENT"`
+	AllowedOrigins        []string      `mapstructure:"ALLOWED_ORIGINS"`
+	TrustedProxies        []string      `mapstructure:"TRUSTED_PROXIES"`
+	DBSource              string        `mapstructure:"DB_SOURCE"`
+	MigrationURL          string        `mapstructure:"MIGRATION_URL"`
+	RedisAddress          string        `mapstructure:"REDIS_ADDRESS"`
+	HTTPServerAddress     string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GRPCServerAddress     string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	TokenMaker            string        `mapstructure:"TOKEN_MAKER"`
+	TokenSymmetricKey     string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	TokenSigningKeyID     string        `mapstructure:"TOKEN_SIGNING_KEY_ID"`
+	TokenSigningKey       string        `mapstructure:"TOKEN_SIGNING_KEY"`
+	TokenVerificationKeys []string      `mapstructure:"TOKEN_VERIFICATION_KEYS"`
+	TokenIssuer           string        `mapstructure:"TOKEN_ISSUER"`
+	TokenAudience         string        `mapstructure:"TOKEN_AUDIENCE"`
+	AccessTokenDuration   time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration  time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	SessionCacheTTL       time.Duration `mapstructure:"SESSION_CACHE_TTL"`
+	PermissionCacheTTL    time.Duration `mapstructure:"PERMISSION_CACHE_TTL"`
+	MFATokenDuration      time.Duration `mapstructure:"MFA_TOKEN_DURATION"`
+	TOTPIssuer            string        `mapstructure:"TOTP_ISSUER"`
+	TOTPEncryptionKey     string        `mapstructure:"TOTP_ENCRYPTION_KEY"`
+	WebhookEncryptionKey  string        `mapstructure:"WEBHOOK_ENCRYPTION_KEY"`
+	PasswordHasher        string        `mapstructure:"PASSWORD_HASHER"`
+	Argon2Memory          uint32        `mapstructure:"ARGON2_MEMORY"`
+	Argon2Iterations      uint32        `mapstructure:"ARGON2_ITERATIONS"`
+	Argon2Parallelism     uint8         `mapstructure:"ARGON2_PARALLELISM"`
+	BcryptCost            int           `mapstructure:"BCRYPT_COST"`
+	VerifyEmailInterval   time.Duration `mapstructure:"VERIFY_EMAIL_INTERVAL"`
+	PasswordResetInterval time.Duration `mapstructure:"PASSWORD_RESET_INTERVAL"`
+	OutboxRelayInterval   time.Duration `mapstructure:"OUTBOX_RELAY_INTERVAL"`
+	LoginAttemptStore     string        `mapstructure:"LOGIN_ATTEMPT_STORE"`
+	LoginMaxFailures      int64         `mapstructure:"LOGIN_MAX_FAILURES"`
+	LoginMaxIPFailures    int64         `mapstructure:"LOGIN_MAX_IP_FAILURES"`
+	LoginLockoutDuration  time.Duration `mapstructure:"LOGIN_LOCKOUT_DURATION"`
+	LoginFailureDelay     time.Duration `mapstructure:"LOGIN_FAILURE_DELAY"`
+	LoginMaxFailureDelay  time.Duration `mapstructure:"LOGIN_MAX_FAILURE_DELAY"`
+	LoginMaxMFAAttempts   int64         `mapstructure:"LOGIN_MAX_MFA_ATTEMPTS"`
+	EmailSenderName       string        `mapstructure:"EMAIL_SENDER_NAME"`
+	EmailSenderAddress    string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	EmailSenderPassword   string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -5,12 +5,13 @@ const (
 	USD = "USD"
 	EUR = "EUR"
 	CAD = "CAD"
+	GBP = "GBP"
 )
 
 // IsSupportedCurrency returns true if the currency is supported
 func IsSupportedCurrency(currency string) bool {
 	switch currency {
-	case USD, EUR, CAD:
+	case USD, EUR, CAD, GBP:
 		return true
 	}
 	return false
@@ -0,0 +1,17 @@
+package util
+
+// Domain events that webhook subscriptions can receive
+const (
+	EventTransferCreated   = "transfer.created"
+	EventAccountCreated    = "account.created"
+	EventUserEmailVerified = "user.email_verified"
+)
+
+// IsDomainEvent returns true if webhooks can subscribe to the event type
+func IsDomainEvent(eventType string) bool {
+	switch eventType {
+	case EventTransferCreated, EventAccountCreated, EventUserEmailVerified:
+		return true
+	}
+	return false
+}
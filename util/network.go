@@ -0,0 +1,17 @@
+package util
+
+import "net/netip"
+
+// IsPublicIP reports whether requests made on behalf of users may reach ip,
+// that is, it is not a loopback, private, link-local, multicast or unspecified address
+func IsPublicIP(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() &&
+		!ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
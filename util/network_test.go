@@ -0,0 +1,31 @@
+package util
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	for _, ip := range []string{"203.0.113.10", "8.8.8.8", "2001:4860:4860::8888"} {
+		require.True(t, IsPublicIP(netip.MustParseAddr(ip)), ip)
+	}
+
+	for _, ip := range []string{
+		"127.0.0.1",
+		"::1",
+		"10.1.2.3",
+		"172.16.0.1",
+		"192.168.1.1",
+		"fd00::1",
+		"169.254.169.254",
+		"fe80::1",
+		"0.0.0.0",
+		"::",
+		"224.0.0.1",
+		"::ffff:127.0.0.1",
+	} {
+		require.False(t, IsPublicIP(netip.MustParseAddr(ip)), ip)
+	}
+}
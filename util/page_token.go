@@ -0,0 +1,74 @@
+package util
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidPageToken is returned when a page token cannot be decoded
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// PageToken is the position of the last row of a page in a list ordered by (created_at, id).
+// Clients only ever see it as an opaque string.
+type PageToken struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// EncodePageToken encodes the position after which the next page starts
+func EncodePageToken(createdAt time.Time, id int64) string {
+	raw := fmt.Sprintf("%d:%d", createdAt.UnixMicro(), id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodePageToken decodes a token made by EncodePageToken.
+// An empty token is the position before the first row.
+func DecodePageToken(token string) (PageToken, error) {
+	var pageToken PageToken
+	if token == "" {
+		return pageToken, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageToken, ErrInvalidPageToken
+	}
+
+	rawCreatedAt, rawID, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return pageToken, ErrInvalidPageToken
+	}
+
+	micros, err := strconv.ParseInt(rawCreatedAt, 10, 64)
+	if err != nil {
+		return pageToken, ErrInvalidPageToken
+	}
+
+	pageToken.ID, err = strconv.ParseInt(rawID, 10, 64)
+	if err != nil || pageToken.ID <= 0 {
+		return pageToken, ErrInvalidPageToken
+	}
+
+	pageToken.CreatedAt = time.UnixMicro(micros).UTC()
+	return pageToken, nil
+}
+
+// EncodeKeyPageToken encodes the key of the last row of a page in a list ordered by a unique string key
+func EncodeKeyPageToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// DecodeKeyPageToken decodes a token made by EncodeKeyPageToken.
+// An empty token is the position before the first row.
+func DecodeKeyPageToken(token string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", ErrInvalidPageToken
+	}
+
+	return string(raw), nil
+}
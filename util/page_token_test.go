@@ -0,0 +1,53 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageToken(t *testing.T) {
+	createdAt := time.Now().Truncate(time.Microsecond)
+	id := RandomInt(1, 1000)
+
+	token := EncodePageToken(createdAt, id)
+	require.NotEmpty(t, token)
+
+	pageToken, err := DecodePageToken(token)
+	require.NoError(t, err)
+	require.Equal(t, id, pageToken.ID)
+	require.True(t, createdAt.Equal(pageToken.CreatedAt))
+}
+
+func TestEmptyPageToken(t *testing.T) {
+	pageToken, err := DecodePageToken("")
+	require.NoError(t, err)
+	require.Zero(t, pageToken.ID)
+	require.True(t, pageToken.CreatedAt.IsZero())
+}
+
+func TestInvalidPageToken(t *testing.T) {
+	for _, token := range []string{"not base64!", "bm9jb2xvbg", "YWJjOjE", "MTIzOjA"} {
+		_, err := DecodePageToken(token)
+		require.ErrorIs(t, err, ErrInvalidPageToken, token)
+	}
+}
+
+func TestKeyPageToken(t *testing.T) {
+	key := RandomOwner()
+
+	token := EncodeKeyPageToken(key)
+	require.NotEqual(t, key, token)
+
+	decodedKey, err := DecodeKeyPageToken(token)
+	require.NoError(t, err)
+	require.Equal(t, key, decodedKey)
+
+	decodedKey, err = DecodeKeyPageToken("")
+	require.NoError(t, err)
+	require.Empty(t, decodedKey)
+
+	_, err = DecodeKeyPageToken("not base64!")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
@@ -1,11 +1,223 @@
 package util
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Different password hashing algorithms
+const (
+	Argon2id = "argon2id"
+	Bcrypt   = "bcrypt"
+)
+
+// Different types of error returned when checking a password.
+// A mismatch is reported with bcrypt's error whatever the algorithm, as callers already compare against it.
+var (
+	ErrMismatchedPassword      = bcrypt.ErrMismatchedHashAndPassword
+	ErrUnsupportedPasswordHash = errors.New("unsupported password hash")
+)
+
+// PasswordHasher hashes passwords into self-describing strings,
+// so that hashes made with different algorithms or parameters can coexist.
+type PasswordHasher interface {
+	// Hash returns the encoded hash of the password
+	Hash(password string) (string, error)
+
+	// Check returns ErrMismatchedPassword if the password does not match the hash.
+	// Every supported algorithm is accepted, whatever the hasher uses for new hashes.
+	Check(password string, hashedPassword string) error
+
+	// NeedsRehash reports whether the hash was not made with the hasher's current algorithm and parameters
+	NeedsRehash(hashedPassword string) bool
+}
+
+// NewPasswordHasherFromConfig creates the PasswordHasher selected by PASSWORD_HASHER.
+// bcrypt is used by default, as it is what older hashes were made with.
+func NewPasswordHasherFromConfig(config Config) (PasswordHasher, error) {
+	switch config.PasswordHasher {
+	case "", Bcrypt:
+		cost := config.BcryptCost
+		if cost == 0 {
+			cost = bcrypt.DefaultCost
+		}
+		return NewBcryptHasher(cost)
+	case Argon2id:
+		params := DefaultArgon2idParams
+		if config.Argon2Memory != 0 {
+			params.Memory = config.Argon2Memory
+		}
+		if config.Argon2Iterations != 0 {
+			params.Iterations = config.Argon2Iterations
+		}
+		if config.Argon2Parallelism != 0 {
+			params.Parallelism = config.Argon2Parallelism
+		}
+		return NewArgon2idHasher(params), nil
+	default:
+		return nil, fmt.Errorf("unsupported password hasher: %s", config.PasswordHasher)
+	}
+}
+
+// checkPassword verifies the password against a hash of any supported algorithm
+func checkPassword(password string, hashedPassword string) error {
+	switch {
+	case strings.HasPrefix(hashedPassword, "$argon2id$"):
+		params, salt, key, err := decodeArgon2idHash(hashedPassword)
+		if err != nil {
+			return err
+		}
+
+		otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+		if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+			return ErrMismatchedPassword
+		}
+		return nil
+	case strings.HasPrefix(hashedPassword, "$2"):
+		return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	default:
+		return ErrUnsupportedPasswordHash
+	}
+}
+
+// Argon2idParams are the cost parameters of argon2id
+type Argon2idParams struct {
+	// Memory is in KiB
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2idParams follows the OWASP recommendation for argon2id
+var DefaultArgon2idParams = Argon2idParams{
+	Memory:      19 * 1024,
+	Iterations:  2,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Argon2idHasher makes PHC-encoded argon2id hashes
+type Argon2idHasher struct {
+	params Argon2idParams
+}
+
+// NewArgon2idHasher creates a new PasswordHasher using argon2id
+func NewArgon2idHasher(params Argon2idParams) PasswordHasher {
+	return &Argon2idHasher{
+		params: params,
+	}
+}
+
+// Hash returns a hash like $argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>
+func (hasher *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, hasher.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, hasher.params.Iterations, hasher.params.Memory, hasher.params.Parallelism, hasher.params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		hasher.params.Memory,
+		hasher.params.Iterations,
+		hasher.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (hasher *Argon2idHasher) Check(password string, hashedPassword string) error {
+	return checkPassword(password, hashedPassword)
+}
+
+func (hasher *Argon2idHasher) NeedsRehash(hashedPassword string) bool {
+	params, salt, key, err := decodeArgon2idHash(hashedPassword)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory != hasher.params.Memory ||
+		params.Iterations != hasher.params.Iterations ||
+		params.Parallelism != hasher.params.Parallelism ||
+		uint32(len(salt)) != hasher.params.SaltLength ||
+		uint32(len(key)) != hasher.params.KeyLength
+}
+
+func decodeArgon2idHash(hashedPassword string) (params Argon2idParams, salt []byte, key []byte, err error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != Argon2id {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
+
+// BcryptHasher makes bcrypt hashes, in their own $2a$<cost>$ encoding
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates a new PasswordHasher using bcrypt
+func NewBcryptHasher(cost int) (PasswordHasher, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("invalid bcrypt cost: must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
+	return &BcryptHasher{
+		cost: cost,
+	}, nil
+}
+
+func (hasher *BcryptHasher) Hash(password string) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), hasher.cost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hashedPassword), nil
+}
+
+func (hasher *BcryptHasher) Check(password string, hashedPassword string) error {
+	return checkPassword(password, hashedPassword)
+}
+
+func (hasher *BcryptHasher) NeedsRehash(hashedPassword string) bool {
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	return err != nil || cost != hasher.cost
+}
+
 // HashPassword returns the bcrypt hash of the password
 func HashPassword(password string) (string, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -15,7 +227,8 @@ func HashPassword(password string) (string, error) {
 	return string(hashedPassword), nil
 }
 
-// CheckPassword checks if the provided password is correct or not
+// CheckPassword checks if the provided password is correct or not.
+// The hash can be made with any supported algorithm.
 func CheckPassword(password string, hashedPassword string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	return checkPassword(password, hashedPassword)
 }
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,3 +27,87 @@ func TestPassword(t *testing.T) {
 	require.NotEmpty(t, hashedPassword2)
 	require.NotEqual(t, hashedPassword1, hashedPassword2)
 }
+
+var testArgon2idParams = Argon2idParams{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestArgon2idHasher(t *testing.T) {
+	hasher := NewArgon2idHasher(testArgon2idParams)
+	password := RandomString(6)
+
+	hashedPassword1, err := hasher.Hash(password)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hashedPassword1, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	require.NoError(t, hasher.Check(password, hashedPassword1))
+	require.ErrorIs(t, hasher.Check(RandomString(6), hashedPassword1), ErrMismatchedPassword)
+	require.False(t, hasher.NeedsRehash(hashedPassword1))
+
+	hashedPassword2, err := hasher.Hash(password)
+	require.NoError(t, err)
+	require.NotEqual(t, hashedPassword1, hashedPassword2)
+
+	// stronger parameters make older hashes outdated
+	stronger := testArgon2idParams
+	stronger.Iterations = 2
+	require.True(t, NewArgon2idHasher(stronger).NeedsRehash(hashedPassword1))
+	require.NoError(t, NewArgon2idHasher(stronger).Check(password, hashedPassword1))
+
+	require.ErrorIs(t, hasher.Check(password, "$argon2id$v=19$m=1024$salt$key"), ErrUnsupportedPasswordHash)
+	require.ErrorIs(t, hasher.Check(password, "plain"), ErrUnsupportedPasswordHash)
+}
+
+func TestPasswordHashersCoexist(t *testing.T) {
+	password := RandomString(6)
+	argon2idHasher := NewArgon2idHasher(testArgon2idParams)
+	bcryptHasher, err := NewBcryptHasher(bcrypt.MinCost)
+	require.NoError(t, err)
+
+	bcryptHash, err := bcryptHasher.Hash(password)
+	require.NoError(t, err)
+	argon2idHash, err := argon2idHasher.Hash(password)
+	require.NoError(t, err)
+
+	// each hasher checks both kinds of hash
+	require.NoError(t, argon2idHasher.Check(password, bcryptHash))
+	require.NoError(t, bcryptHasher.Check(password, argon2idHash))
+	require.NoError(t, CheckPassword(password, argon2idHash))
+
+	// but only its own kind is up to date
+	require.True(t, argon2idHasher.NeedsRehash(bcryptHash))
+	require.True(t, bcryptHasher.NeedsRehash(argon2idHash))
+	require.False(t, bcryptHasher.NeedsRehash(bcryptHash))
+
+	// a different cost is outdated too
+	strongerBcryptHasher, err := NewBcryptHasher(bcrypt.MinCost + 1)
+	require.NoError(t, err)
+	require.True(t, strongerBcryptHasher.NeedsRehash(bcryptHash))
+}
+
+func TestNewPasswordHasherFromConfig(t *testing.T) {
+	hasher, err := NewPasswordHasherFromConfig(Config{})
+	require.NoError(t, err)
+	require.IsType(t, &BcryptHasher{}, hasher)
+
+	hasher, err = NewPasswordHasherFromConfig(Config{
+		PasswordHasher:   Argon2id,
+		Argon2Memory:     2048,
+		Argon2Iterations: 1,
+	})
+	require.NoError(t, err)
+
+	hashedPassword, err := hasher.Hash(RandomString(6))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hashedPassword, "$argon2id$v=19$m=2048,t=1,p=1$"))
+
+	_, err = NewPasswordHasherFromConfig(Config{PasswordHasher: Bcrypt, BcryptCost: 100})
+	require.Error(t, err)
+
+	_, err = NewPasswordHasherFromConfig(Config{PasswordHasher: "md5"})
+	require.Error(t, err)
+}
@@ -1,6 +1,77 @@
 package util
 
+import "strings"
+
 const (
 	DepositorRole = "depositor"
 	BankerRole    = "banker"
 )
+
+// Permissions are named resource:action:scope. A permission with the any
+// scope also grants the same permission with the own scope.
+const (
+	PermissionAccountsCreateOwn      = "accounts:create:own"
+	PermissionAccountsReadOwn        = "accounts:read:own"
+	PermissionAccountsReadAny        = "accounts:read:any"
+	PermissionAccountsFreezeAny      = "accounts:freeze:any"
+	PermissionAccountsAdjustAny      = "accounts:adjust:any"
+	PermissionAccountsCloseOwn       = "accounts:close:own"
+	PermissionAccountsCloseAny       = "accounts:close:any"
+	PermissionAccountsReopenAny      = "accounts:reopen:any"
+	PermissionTransfersCreateOwn     = "transfers:create:own"
+	PermissionUsersReadAny           = "users:read:any"
+	PermissionUsersUpdateOwn         = "users:update:own"
+	PermissionUsersUpdateAny         = "users:update:any"
+	PermissionSessionsManageOwn      = "sessions:manage:own"
+	PermissionRolesReadAny           = "roles:read:any"
+	PermissionRolesAssignAny         = "roles:assign:any"
+	PermissionAuditReadAny           = "audit:read:any"
+	PermissionWebhooksManageOwn      = "webhooks:manage:own"
+	PermissionWebhooksManageAny      = "webhooks:manage:any"
+	PermissionExchangeRatesManageAny = "exchange_rates:manage:any"
+)
+
+const (
+	ownScope = ":own"
+	anyScope = ":any"
+)
+
+// DefaultRolePermissions are the permissions seeded in the role_permissions table by the migrations
+var DefaultRolePermissions = map[string][]string{
+	DepositorRole: {
+		PermissionAccountsCreateOwn,
+		PermissionAccountsReadOwn,
+		PermissionAccountsCloseOwn,
+		PermissionTransfersCreateOwn,
+		PermissionUsersUpdateOwn,
+		PermissionSessionsManageOwn,
+		PermissionWebhooksManageOwn,
+	},
+	BankerRole: {
+		PermissionAccountsCreateOwn,
+		PermissionAccountsReadAny,
+		PermissionAccountsFreezeAny,
+		PermissionAccountsAdjustAny,
+		PermissionAccountsCloseAny,
+		PermissionAccountsReopenAny,
+		PermissionTransfersCreateOwn,
+		PermissionUsersReadAny,
+		PermissionUsersUpdateAny,
+		PermissionSessionsManageOwn,
+		PermissionRolesReadAny,
+		PermissionRolesAssignAny,
+		PermissionAuditReadAny,
+		PermissionWebhooksManageAny,
+		PermissionExchangeRatesManageAny,
+	},
+}
+
+// GrantsPermission reports whether holding granted allows what permission requires
+func GrantsPermission(granted string, permission string) bool {
+	if granted == permission {
+		return true
+	}
+
+	resourceAction, ok := strings.CutSuffix(permission, ownScope)
+	return ok && granted == resourceAction+anyScope
+}
@@ -0,0 +1,17 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantsPermission(t *testing.T) {
+	require.True(t, GrantsPermission(PermissionAccountsReadOwn, PermissionAccountsReadOwn))
+	require.True(t, GrantsPermission(PermissionAccountsReadAny, PermissionAccountsReadOwn))
+	require.True(t, GrantsPermission(PermissionAccountsReadAny, PermissionAccountsReadAny))
+
+	require.False(t, GrantsPermission(PermissionAccountsReadOwn, PermissionAccountsReadAny))
+	require.False(t, GrantsPermission(PermissionAccountsReadAny, PermissionAccountsCreateOwn))
+	require.False(t, GrantsPermission(PermissionUsersUpdateAny, PermissionAccountsReadOwn))
+}
@@ -3,12 +3,19 @@ package val
 import (
 	"fmt"
 	"net/mail"
+	"net/url"
 	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/simplebank/util"
 )
 
 var (
-	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
-	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidUsername     = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
+	isValidFullName     = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidTOTPCode     = regexp.MustCompile(`^[0-9]{6}$`).MatchString
+	isValidRecoveryCode = regexp.MustCompile(`^[a-zA-Z2-7]{4}-?[a-zA-Z2-7]{4}$`).MatchString
+	isValidRole         = regexp.MustCompile(`^[a-z_]+$`).MatchString
 )
 
 func ValidateString(value string, minLength int, maxLength int) error {
@@ -60,6 +67,159 @@ func ValidateEmailId(value int64) error {
 	return nil
 }
 
+func ValidateResetId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
 func ValidateSecretCode(value string) error {
 	return ValidateString(value, 32, 128)
 }
+
+func ValidateAccountId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateCurrency(value string) error {
+	if !util.IsSupportedCurrency(value) {
+		return fmt.Errorf("unsupported currency")
+	}
+	return nil
+}
+
+func ValidatePageSize(value int32) error {
+	if value < 1 || value > 50 {
+		return fmt.Errorf("must be from 1-50")
+	}
+	return nil
+}
+
+func ValidatePageToken(value string) error {
+	_, err := util.DecodePageToken(value)
+	return err
+}
+
+func ValidateKeyPageToken(value string) error {
+	_, err := util.DecodeKeyPageToken(value)
+	return err
+}
+
+func ValidateAmount(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateAdjustmentAmount(value int64) error {
+	if value == 0 {
+		return fmt.Errorf("must not be zero")
+	}
+	return nil
+}
+
+func ValidateIdempotencyKey(value string) error {
+	return ValidateString(value, 8, 128)
+}
+
+func ValidateSessionId(value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return fmt.Errorf("must be a valid UUID")
+	}
+	return nil
+}
+
+func ValidateToken(value string) error {
+	if value == "" {
+		return fmt.Errorf("must not be empty")
+	}
+	return nil
+}
+
+func ValidateTOTPCode(value string) error {
+	if !isValidTOTPCode(value) {
+		return fmt.Errorf("must contain exactly 6 digits")
+	}
+	return nil
+}
+
+func ValidateTwoFactorCode(value string) error {
+	if !isValidTOTPCode(value) && !isValidRecoveryCode(value) {
+		return fmt.Errorf("must be a 6-digit code or a recovery code")
+	}
+	return nil
+}
+
+func ValidateRole(value string) error {
+	if err := ValidateString(value, 3, 50); err != nil {
+		return err
+	}
+	if !isValidRole(value) {
+		return fmt.Errorf("must contain only lowercase letters or underscore")
+	}
+	return nil
+}
+
+func ValidateReason(value string) error {
+	return ValidateString(value, 3, 500)
+}
+
+func ValidateSearchQuery(value string) error {
+	return ValidateString(value, 1, 100)
+}
+
+func ValidateAuditAction(value string) error {
+	if !util.IsAuditAction(value) {
+		return fmt.Errorf("unknown audit action")
+	}
+	return nil
+}
+
+func ValidateAuditTargetType(value string) error {
+	if !util.IsAuditTarget(value) {
+		return fmt.Errorf("unknown audit target type")
+	}
+	return nil
+}
+
+func ValidateAuditTargetId(value string) error {
+	return ValidateString(value, 1, 100)
+}
+
+func ValidateWebhookURL(value string) error {
+	if err := ValidateString(value, 1, 2048); err != nil {
+		return err
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("is not a valid absolute URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("must use http or https")
+	}
+	return nil
+}
+
+func ValidateEventTypes(values []string) error {
+	if len(values) == 0 {
+		return fmt.Errorf("must contain at least one event type")
+	}
+	for _, value := range values {
+		if !util.IsDomainEvent(value) {
+			return fmt.Errorf("unknown event type %q", value)
+		}
+	}
+	return nil
+}
+
+func ValidateSubscriptionId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
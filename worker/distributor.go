@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hibiken/asynq"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 )
 
 type TaskDistributor interface {
@@ -12,6 +13,28 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskSendResetPasswordEmail(
+		ctx context.Context,
+		payload *PayloadSendResetPasswordEmail,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendVerifyEmailChange(
+		ctx context.Context,
+		payload *PayloadSendVerifyEmailChange,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendEmailChangeNotice(
+		ctx context.Context,
+		payload *PayloadSendEmailChangeNotice,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendLoginLockoutEmail(
+		ctx context.Context,
+		payload *PayloadSendLoginLockoutEmail,
+		opts ...asynq.Option,
+	) error
+	// DistributeOutboxTask publishes a task that was written to the outbox
+	DistributeOutboxTask(ctx context.Context, task db.OutboxTask) error
 }
 
 type RedisTaskDistributor struct {
@@ -8,6 +8,7 @@ import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
 	asynq "github.com/hibiken/asynq"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	worker "github.com/spaghetti-lover/simplebank/worker"
 	reflect "reflect"
 )
@@ -35,6 +36,77 @@ func (m *MockTaskDistributor) EXPECT() *MockTaskDistributorMockRecorder {
 	return m.recorder
 }
 
+// DistributeOutboxTask mocks base method
+func (m *MockTaskDistributor) DistributeOutboxTask(arg0 context.Context, arg1 db.OutboxTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeOutboxTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeOutboxTask indicates an expected call of DistributeOutboxTask
+func (mr *MockTaskDistributorMockRecorder) DistributeOutboxTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeOutboxTask", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeOutboxTask), arg0, arg1)
+}
+
+// DistributeTaskSendEmailChangeNotice mocks base method
+func (m *MockTaskDistributor) DistributeTaskSendEmailChangeNotice(arg0 context.Context, arg1 *worker.PayloadSendEmailChangeNotice, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendEmailChangeNotice", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendEmailChangeNotice indicates an expected call of DistributeTaskSendEmailChangeNotice
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendEmailChangeNotice(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendEmailChangeNotice", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendEmailChangeNotice), varargs...)
+}
+
+// DistributeTaskSendLoginLockoutEmail mocks base method
+func (m *MockTaskDistributor) DistributeTaskSendLoginLockoutEmail(arg0 context.Context, arg1 *worker.PayloadSendLoginLockoutEmail, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendLoginLockoutEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendLoginLockoutEmail indicates an expected call of DistributeTaskSendLoginLockoutEmail
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendLoginLockoutEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendLoginLockoutEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendLoginLockoutEmail), varargs...)
+}
+
+// DistributeTaskSendResetPasswordEmail mocks base method
+func (m *MockTaskDistributor) DistributeTaskSendResetPasswordEmail(arg0 context.Context, arg1 *worker.PayloadSendResetPasswordEmail, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendResetPasswordEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendResetPasswordEmail indicates an expected call of DistributeTaskSendResetPasswordEmail
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendResetPasswordEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendResetPasswordEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendResetPasswordEmail), varargs...)
+}
+
 // DistributeTaskSendVerifyEmail mocks base method
 func (m *MockTaskDistributor) DistributeTaskSendVerifyEmail(arg0 context.Context, arg1 *worker.PayloadSendVerifyEmail, arg2 ...asynq.Option) error {
 	m.ctrl.T.Helper()
@@ -53,3 +125,22 @@ func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendVerifyEmail(arg0, a
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendVerifyEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendVerifyEmail), varargs...)
 }
+
+// DistributeTaskSendVerifyEmailChange mocks base method
+func (m *MockTaskDistributor) DistributeTaskSendVerifyEmailChange(arg0 context.Context, arg1 *worker.PayloadSendVerifyEmailChange, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendVerifyEmailChange", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendVerifyEmailChange indicates an expected call of DistributeTaskSendVerifyEmailChange
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendVerifyEmailChange(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendVerifyEmailChange", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendVerifyEmailChange), varargs...)
+}
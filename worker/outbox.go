@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+)
+
+// OutboxTaskOptions tells how the worker runs a task written to the outbox
+type OutboxTaskOptions struct {
+	Queue     string
+	MaxRetry  int
+	ProcessIn time.Duration
+}
+
+// NewOutboxTask prepares a task to be written to the outbox by a database transaction.
+// The OutboxRelay publishes it once the transaction is committed.
+func NewOutboxTask(taskType string, payload any, opts OutboxTaskOptions) (db.CreateOutboxTaskParams, error) {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return db.CreateOutboxTaskParams{}, fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return db.CreateOutboxTaskParams{
+		TaskType:  taskType,
+		Payload:   jsonPayload,
+		Queue:     opts.Queue,
+		MaxRetry:  int32(opts.MaxRetry),
+		ProcessAt: time.Now().Add(opts.ProcessIn),
+	}, nil
+}
+
+func (distributor *RedisTaskDistributor) DistributeOutboxTask(ctx context.Context, outboxTask db.OutboxTask) error {
+	// the task id makes asynq refuse a task the relay already published but could not mark sent
+	task := asynq.NewTask(
+		outboxTask.TaskType,
+		outboxTask.Payload,
+		asynq.TaskID(fmt.Sprintf("outbox:%d", outboxTask.ID)),
+		asynq.Queue(outboxTask.Queue),
+		asynq.MaxRetry(int(outboxTask.MaxRetry)),
+		asynq.ProcessAt(outboxTask.ProcessAt),
+	)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		log.Info().Str("type", task.Type()).Int64("outbox_id", outboxTask.ID).Msg("task already enqueued")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).Int64("outbox_id", outboxTask.ID).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
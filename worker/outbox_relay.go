@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+)
+
+const (
+	outboxRelayBatchSize = 100
+	// outboxMaxAttempts failed publishes, close to two hours with outboxRetryDelay, leave a task pending for good
+	outboxMaxAttempts = 20
+	// outboxClaimTimeout must be longer than publishing a batch takes, or its tasks are published twice
+	outboxClaimTimeout  = time.Minute
+	outboxMinRetryDelay = time.Second
+	outboxMaxRetryDelay = 10 * time.Minute
+)
+
+// OutboxRelay publishes the tasks written to the outbox through a TaskDistributor.
+// A task is published at least once, so the task handlers must tolerate duplicates.
+type OutboxRelay struct {
+	store       db.Store
+	distributor TaskDistributor
+	interval    time.Duration
+}
+
+// NewOutboxRelay creates a new OutboxRelay that looks for pending tasks every interval
+func NewOutboxRelay(store db.Store, distributor TaskDistributor, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		store:       store,
+		distributor: distributor,
+		interval:    interval,
+	}
+}
+
+// Run relays the pending tasks every interval until ctx is done
+func (relay *OutboxRelay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(relay.interval)
+	defer ticker.Stop()
+
+	for {
+		err := relay.RelayPending(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("failed to relay outbox tasks")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending publishes the pending tasks in batches, until none is left or one fails to publish
+func (relay *OutboxRelay) RelayPending(ctx context.Context) error {
+	for {
+		result, err := relay.store.RelayOutbox(ctx, db.RelayOutboxParams{
+			Limit:        outboxRelayBatchSize,
+			MaxAttempts:  outboxMaxAttempts,
+			ClaimTimeout: outboxClaimTimeout,
+			RetryDelay:   outboxRetryDelay,
+			Publish: func(task db.OutboxTask) error {
+				err := relay.distributor.DistributeOutboxTask(ctx, task)
+				if err != nil && task.Attempts+1 >= outboxMaxAttempts {
+					log.Error().Err(err).Str("type", task.TaskType).Int64("outbox_id", task.ID).
+						Msg("giving up on outbox task after too many failed attempts")
+				}
+				return err
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to relay outbox tasks: %w", err)
+		}
+
+		if result.Failed > 0 {
+			return fmt.Errorf("failed to publish %d outbox tasks", result.Failed)
+		}
+
+		if result.Sent < outboxRelayBatchSize {
+			return nil
+		}
+	}
+}
+
+// outboxRetryDelay doubles the delay after each failed attempt, up to outboxMaxRetryDelay
+func outboxRetryDelay(attempts int32) time.Duration {
+	delay := outboxMinRetryDelay
+	for i := int32(1); i < attempts && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetryDelay)
+}
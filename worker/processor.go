@@ -2,10 +2,12 @@ package worker
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/simplebank/auth"
 	db "github.com/spaghetti-lover/simplebank/db/sqlc"
 	"github.com/spaghetti-lover/simplebank/mail"
 )
@@ -19,15 +21,29 @@ type TaskProcessor interface {
 	Start() error
 	Shutdown()
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendResetPasswordEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendVerifyEmailChange(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendEmailChangeNotice(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendLoginLockoutEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
-	server *asynq.Server
-	store  db.Store
-	mailer mail.EmailSender
+	server        *asynq.Server
+	store         db.Store
+	mailer        mail.EmailSender
+	webhookCipher *auth.SecretCipher
+	webhookClient *http.Client
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender) TaskProcessor {
+// NewRedisTaskProcessor creates a new TaskProcessor.
+// Webhooks cannot be delivered without the webhookCipher that decrypts their secrets.
+func NewRedisTaskProcessor(
+	redisOpt asynq.RedisClientOpt,
+	store db.Store,
+	mailer mail.EmailSender,
+	webhookCipher *auth.SecretCipher,
+) TaskProcessor {
 	logger := NewLogger()
 	redis.SetLogger(logger)
 
@@ -47,9 +63,11 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer
 	)
 
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		mailer: mailer,
+		server:        server,
+		store:         store,
+		mailer:        mailer,
+		webhookCipher: webhookCipher,
+		webhookClient: newWebhookClient(),
 	}
 }
 
@@ -57,6 +75,11 @@ func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskSendResetPasswordEmail, processor.ProcessTaskSendResetPasswordEmail)
+	mux.HandleFunc(TaskSendVerifyEmailChange, processor.ProcessTaskSendVerifyEmailChange)
+	mux.HandleFunc(TaskSendEmailChangeNotice, processor.ProcessTaskSendEmailChangeNotice)
+	mux.HandleFunc(TaskSendLoginLockoutEmail, processor.ProcessTaskSendLoginLockoutEmail)
+	mux.HandleFunc(TaskDeliverWebhook, processor.ProcessTaskDeliverWebhook)
 
 	return processor.server.Start(mux)
 }
@@ -0,0 +1,172 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/simplebank/auth"
+	db "github.com/spaghetti-lover/simplebank/db/sqlc"
+	"github.com/spaghetti-lover/simplebank/util"
+)
+
+const TaskDeliverWebhook = "task:deliver_webhook"
+
+// DeliverWebhookTask is the task that the db transactions emitting domain events write to the outbox
+var DeliverWebhookTask = db.EventTaskOptions{
+	TaskType: TaskDeliverWebhook,
+	Queue:    QueueDefault,
+	MaxRetry: 10,
+}
+
+// Headers of the requests posted to webhooks
+const (
+	WebhookEventTypeHeader = "X-Simplebank-Event"
+	WebhookEventIDHeader   = "X-Simplebank-Event-Id"
+	WebhookTimestampHeader = "X-Simplebank-Timestamp"
+	WebhookSignatureHeader = "X-Simplebank-Signature"
+)
+
+const webhookTimeout = 10 * time.Second
+
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		// the address is checked once resolved, so that a DNS record changed after the subscription
+		// was created cannot send deliveries into our own network
+		Control: func(network string, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil || !util.IsPublicIP(ip) {
+				return fmt.Errorf("webhook address %s is not public", host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: webhookTimeout,
+		Transport: &http.Transport{
+			// a proxy would be dialed instead of the webhook, so none is used
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: webhookTimeout,
+		},
+		// a redirect is reported as a failed delivery instead of posting the event somewhere else
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error {
+	var payload db.PayloadDeliverWebhook
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	subscription, err := processor.store.GetWebhookSubscription(ctx, payload.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			log.Info().Str("type", task.Type()).Int64("subscription_id", payload.SubscriptionID).
+				Msg("webhook subscription was deleted")
+			return nil
+		}
+		return fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+
+	if processor.webhookCipher == nil {
+		return fmt.Errorf("webhook encryption key is not configured: %w", asynq.SkipRetry)
+	}
+
+	secret, err := processor.webhookCipher.Decrypt(subscription.EncryptedSecret)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt webhook secret: %s: %w", err, asynq.SkipRetry)
+	}
+
+	body, err := json.Marshal(payload.Event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", asynq.SkipRetry)
+	}
+
+	statusCode, postErr := processor.postWebhook(ctx, subscription.Url, secret, payload.Event, body)
+
+	var deliveryError string
+	if postErr != nil {
+		deliveryError = postErr.Error()
+	}
+
+	retryCount, _ := asynq.GetRetryCount(ctx)
+	_, err = processor.store.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		SubscriptionID: subscription.ID,
+		EventID:        payload.Event.ID,
+		EventType:      payload.Event.Type,
+		Attempt:        int32(retryCount + 1),
+		StatusCode:     int32(statusCode),
+		Error:          deliveryError,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	if postErr != nil {
+		return fmt.Errorf("failed to deliver webhook: %w", postErr)
+	}
+
+	log.Info().Str("type", task.Type()).Int64("subscription_id", subscription.ID).
+		Str("event_id", payload.Event.ID.String()).Int("status_code", statusCode).Msg("processed task")
+	return nil
+}
+
+// postWebhook posts the signed event to url and returns the status of the response, 0 when there was none.
+// Any status other than 2xx is an error.
+func (processor *RedisTaskProcessor) postWebhook(
+	ctx context.Context,
+	url string,
+	secret string,
+	event db.DomainEvent,
+	body []byte,
+) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventTypeHeader, event.Type)
+	req.Header.Set(WebhookEventIDHeader, event.ID.String())
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(WebhookSignatureHeader, auth.SignWebhookPayload(secret, timestamp, body))
+
+	rsp, err := processor.webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer rsp.Body.Close()
+
+	// reading the body lets the connection be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(rsp.Body, 64<<10))
+
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return rsp.StatusCode, fmt.Errorf("unexpected response status: %s", rsp.Status)
+	}
+	return rsp.StatusCode, nil
+}